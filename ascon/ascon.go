@@ -0,0 +1,282 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+// Package ascon implements the Ascon-Hash256 hash function and the Ascon-XOF128 and
+// Ascon-CXOF128 extendable-output functions as specified in NIST SP 800-232.
+package ascon
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash"
+	"math/bits"
+	"slices"
+)
+
+// ******** Public constants ********
+
+// Size is the size of an Ascon-Hash256 hash value and the default output size of the
+// extendable-output functions in bytes.
+const Size = 32
+
+// BlockSize is the rate of the Ascon sponge in bytes.
+const BlockSize = 8
+
+// MaxCustomizationSize is the maximum size of an Ascon-CXOF128 customization string in bytes.
+const MaxCustomizationSize = 256
+
+// ******** Public variables ********
+
+// ErrCustomizationTooLong is returned when a customization string is longer than MaxCustomizationSize.
+var ErrCustomizationTooLong = errors.New(`ascon: customization string must not be longer than 256 bytes`)
+
+// ******** Private constants ********
+
+// rounds is the number of rounds of the permutation.
+const rounds = 12
+
+// These are the initial values of the functions.
+const (
+	ivHash256 uint64 = 0x0000080100cc0002
+	ivXOF128  uint64 = 0x0000080000cc0003
+	ivCXOF128 uint64 = 0x0000080000cc0004
+)
+
+// ******** Private variables ********
+
+// roundConstants contains the round constants of the permutation.
+var roundConstants = [rounds]uint64{0xf0, 0xe1, 0xd2, 0xc3, 0xb4, 0xa5, 0x96, 0x87, 0x78, 0x69, 0x5a, 0x4b}
+
+// ******** Public types ********
+
+// Digest implements the Ascon-Hash256, Ascon-XOF128 and Ascon-CXOF128 functions.
+type Digest struct {
+	state         [5]uint64
+	initialState  [5]uint64
+	buffer        [BlockSize]byte
+	bufferedSize  int
+	outputLength  int
+	squeezing     bool
+	squeezeOffset int
+}
+
+// ******** Public functions ********
+
+// NewHash256 creates a new Ascon-Hash256 hash function.
+func NewHash256() hash.Hash {
+	return newDigest(ivHash256, nil, Size)
+}
+
+// NewXOF128 creates a new Ascon-XOF128 function with the given output length in bytes.
+// If the output length is 0, the default size is used.
+func NewXOF128(outputLength int) *Digest {
+	return newDigest(ivXOF128, nil, outputLength)
+}
+
+// NewCXOF128 creates a new Ascon-CXOF128 function with the given customization string
+// and output length in bytes.
+// If the output length is 0, the default size is used.
+func NewCXOF128(customization []byte, outputLength int) (*Digest, error) {
+	if len(customization) > MaxCustomizationSize {
+		return nil, ErrCustomizationTooLong
+	}
+
+	return newDigest(ivCXOF128, customization, outputLength), nil
+}
+
+// -------- Digest methods --------
+
+// Write adds more data to the running hash.
+// It must not be called after Read.
+func (d *Digest) Write(p []byte) (int, error) {
+	n := len(p)
+
+	if d.bufferedSize != 0 {
+		copied := copy(d.buffer[d.bufferedSize:], p)
+		d.bufferedSize += copied
+		p = p[copied:]
+
+		if d.bufferedSize < BlockSize {
+			return n, nil
+		}
+
+		d.absorb(d.buffer[:])
+		d.bufferedSize = 0
+	}
+
+	for len(p) >= BlockSize {
+		d.absorb(p)
+		p = p[BlockSize:]
+	}
+
+	d.bufferedSize = copy(d.buffer[:], p)
+
+	return n, nil
+}
+
+// Read reads more output from the extendable-output function.
+// After the first call to Read no more data can be written.
+func (d *Digest) Read(p []byte) (int, error) {
+	n := len(p)
+
+	if !d.squeezing {
+		d.pad()
+		d.squeezing = true
+	}
+
+	for len(p) > 0 {
+		if d.squeezeOffset == BlockSize {
+			permute(&d.state)
+			d.squeezeOffset = 0
+		}
+
+		var block [BlockSize]byte
+		binary.LittleEndian.PutUint64(block[:], d.state[0])
+
+		copied := copy(p, block[d.squeezeOffset:])
+		d.squeezeOffset += copied
+		p = p[copied:]
+	}
+
+	return n, nil
+}
+
+// Sum appends the current hash value to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (d *Digest) Sum(b []byte) []byte {
+	result := slices.Grow(b, d.outputLength)[:len(b)+d.outputLength]
+
+	// Reading changes the state. So read from a copy.
+	c := *d
+	_, _ = c.Read(result[len(b):])
+
+	return result
+}
+
+// Reset resets the hash to its initial state.
+func (d *Digest) Reset() {
+	d.state = d.initialState
+	d.bufferedSize = 0
+	d.squeezing = false
+	d.squeezeOffset = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (d *Digest) Size() int {
+	return d.outputLength
+}
+
+// BlockSize returns the hash's underlying block size.
+func (d *Digest) BlockSize() int {
+	return BlockSize
+}
+
+// absorb absorbs one block into the state.
+func (d *Digest) absorb(p []byte) {
+	d.state[0] ^= binary.LittleEndian.Uint64(p)
+	permute(&d.state)
+}
+
+// pad absorbs the buffered data with the padding. The first output block is then in the first state word.
+func (d *Digest) pad() {
+	var block [BlockSize]byte
+	copy(block[:], d.buffer[:d.bufferedSize])
+	block[d.bufferedSize] = 0x01
+	d.absorb(block[:])
+	d.bufferedSize = 0
+}
+
+// ******** Private functions ********
+
+// newDigest creates a new digest with the given initial value.
+// A customization string is absorbed into the initial state, if the initial value is the one of Ascon-CXOF128.
+func newDigest(iv uint64, customization []byte, outputLength int) *Digest {
+	if outputLength == 0 {
+		outputLength = Size
+	}
+
+	d := &Digest{outputLength: outputLength}
+	d.state[0] = iv
+	permute(&d.state)
+
+	if iv == ivCXOF128 {
+		// The customization string is preceded by its length in bits and padded like a message.
+		var length [BlockSize]byte
+		binary.LittleEndian.PutUint64(length[:], uint64(len(customization))<<3)
+		d.absorb(length[:])
+
+		_, _ = d.Write(customization)
+		d.pad()
+	}
+
+	d.initialState = d.state
+	d.Reset()
+
+	return d
+}
+
+// permute applies the Ascon permutation with 12 rounds to the state.
+func permute(s *[5]uint64) {
+	x0, x1, x2, x3, x4 := s[0], s[1], s[2], s[3], s[4]
+
+	for _, c := range roundConstants {
+		// Addition of the round constant.
+		x2 ^= c
+
+		// Substitution layer.
+		x0 ^= x4
+		x4 ^= x3
+		x2 ^= x1
+
+		t0 := ^x0 & x1
+		t1 := ^x1 & x2
+		t2 := ^x2 & x3
+		t3 := ^x3 & x4
+		t4 := ^x4 & x0
+
+		x0 ^= t1
+		x1 ^= t2
+		x2 ^= t3
+		x3 ^= t4
+		x4 ^= t0
+
+		x1 ^= x0
+		x0 ^= x4
+		x3 ^= x2
+		x2 = ^x2
+
+		// Linear diffusion layer.
+		x0 ^= bits.RotateLeft64(x0, -19) ^ bits.RotateLeft64(x0, -28)
+		x1 ^= bits.RotateLeft64(x1, -61) ^ bits.RotateLeft64(x1, -39)
+		x2 ^= bits.RotateLeft64(x2, -1) ^ bits.RotateLeft64(x2, -6)
+		x3 ^= bits.RotateLeft64(x3, -10) ^ bits.RotateLeft64(x3, -17)
+		x4 ^= bits.RotateLeft64(x4, -7) ^ bits.RotateLeft64(x4, -41)
+	}
+
+	s[0], s[1], s[2], s[3], s[4] = x0, x1, x2, x3, x4
+}
@@ -0,0 +1,206 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package ascon
+
+import (
+	"encoding/hex"
+	"errors"
+	"hash"
+	"testing"
+)
+
+// ******** Private constants ********
+
+// katOutputLength is the output length in bytes of the XOF test vectors.
+const katOutputLength = 64
+
+// customizationStart is the value of the first byte of the customization strings of the test vectors.
+const customizationStart = 0x10
+
+// ******** Private types ********
+
+// hashVector is a test vector for a message of the given length.
+type hashVector struct {
+	messageLength int
+	expected      string
+}
+
+// customizationVector is a test vector for a message and a customization string of the given lengths.
+type customizationVector struct {
+	messageLength       int
+	customizationLength int
+	expected            string
+}
+
+// ******** Private variables ********
+
+// The messages of the test vectors consist of the bytes 00 01 02 ... like in the KAT files
+// of the reference implementation. The customization strings consist of the bytes 10 11 12 ...
+
+// hash256Vectors contains the test vectors for Ascon-Hash256.
+var hash256Vectors = []hashVector{
+	{0, `0b3be5850f2f6b98caf29f8fdea89b64a1fa70aa249b8f839bd53baa304d92b2`},
+	{1, `0728621035af3ed2bca03bf6fde900f9456f5330e4b5ee23e7f6a1e70291bc80`},
+	{7, `3e4d273ba69b3b9c53216107e88b75cdbeedbcbf8faf0219c3928ab62b116577`},
+	{8, `b88e497ae8e6fb641b87ef622eb8f2fca0ed95383f7ffebe167acf1099ba764f`},
+	{9, `94269c30e0296e1ec86655041841823efa1927f520fd58c8e9bce6197878c1a6`},
+	{16, `3158c1940a2fbadbd68ab661777859b94a689e4efc375911467addd641835c38`},
+	{17, `f149e99dd0f429599bb89b8079bf3f4dca3f298efefcf9b1ea16fe84f9b8b6e2`},
+	{32, `bd9d3d60a66b53868eab2a5c74539a518a1f60f01eb176c60e43dee81680b33e`},
+}
+
+// xof128Vectors contains the test vectors for Ascon-XOF128 with an output length of 512 bits.
+var xof128Vectors = []hashVector{
+	{0, `473d5e6164f58b39dfd84aacdb8ae42ec2d91fed33388ee0d960d9b3993295c6ad77855a5d3b13fe6ad9e6098988373af7d0956d05a8f1665d2c67d1a3ad10ff`},
+	{1, `51430e0438ecdf642b393630d977625f5f337656ba58ab1e960784ac32a16e0d446405551f5469384f8ea283cf12e64fa72c426bfebaea3aa1529e2c4ab23a2f`},
+	{7, `7ae562db37212a9acd2673ecfd5b4f1c5cb2e6f64ebf00aa7f6ef8dc82c448d5fe11cd91f4368c37690d79e5de0ca8ad419e1918ce8dab2d42363e9476638a7b`},
+	{8, `8d1886f5d3ec4af8d15b44bc62b74da6ea91bc28fb82f9c34079b5ed6e38b6c951803d7dfb3c5e512a0ef5e4060062a6fd067f9c73ef9bee527411bda67fc896`},
+	{9, `db3013bfbbd132dc1d3152fd955ed48f7cbb675e9ad2a2fecf92b74c957592e0c89959e81c16fd07ead9eeb8e40359c497aa20258b43d87ec69ad0bb0993fd38`},
+	{16, `10bfedc5f6442d3e1d8c324878ce1ddf73b01cafc365589283ac4cbb98e48de3ceda8a41bb0983d539e4d90f6458c5c781724fad641ed3cdb4779931097440b3`},
+	{17, `233af64f97ca9bd97bae06270571e57215c5cb5ba4038536c5c128da1d3a379ae13da3e54546a1499014ca03f2eee10b7aa930faa58a3994fd4bcc71f6cb1927`},
+	{32, `2e5f3403f4171471cc7934b51982cece8d6628435db70e89880f3be4e0b7b05232dfe63c44a836d771337c9c5a2688d1b71ecabe0d5c2006fef36ef3186138ad`},
+}
+
+// cxof128Vectors contains the test vectors for Ascon-CXOF128 with an output length of 512 bits.
+// They include the empty and the longest possible customization string and customization strings
+// around the block size.
+var cxof128Vectors = []customizationVector{
+	{0, 0, `4f50159ef70bb3dad8807e034eaebd44c4fa2cbbc8cf1f05511ab66cdcc529905ca12083fc186ad899b270b1473dc5f7ec88d1052082dcdfe69fb75d269e7b74`},
+	{0, 1, `0c93a483e7d574d49fe52cce03ee646117977d57a8aa57704ab4daf44b501430ff6ac11a5d1fd6f2154b5c65728268270c8bb578508487b8965718ada6272fd6`},
+	{1, 0, `7f0c0ddd4bc9603deed19510cdb954d65cf254f59234bfbf5a730d03d2712daab9161c6553f65fa72a25b3174ac13a33218c393577a85b6d6f4319d1ef8a7541`},
+	{8, 7, `cf644f9bb0767d2e3db9b2150c565b67c276257adcc14d16d61d9e2db144f773e7685b8afa053c514c3ebf25a6b53520ff519e90b1b8fee34667fc54700b28e6`},
+	{8, 8, `7c2fc5904cc9ac514902e50747e36f993dbde034cb05587af1432bf81c74b1ec87ecf6179701064494487476f607715853d74c5727925ebf4974e25eb8878919`},
+	{8, 9, `fdf77889c284732cd93d5d6159caa31aae194f99e7dd4d8ba30eee27fa93acd7aab717a18a3145c588eae52fbb3fda8a748d382bd408588229f655c680a13fab`},
+	{16, 255, `3f0f8a4a4d3410f2f98f0b0b559c5333841aa1cc29d0853a23745f515caa2f05a90774eacbcb947635e8c965b622de8b0ca910be1f478ec0dbe092a8151e1676`},
+	{16, 256, `2af586d0dea49af8a269ae7e28a29b1a8c0cc43725dbb0bc2e44ee981889ed1393e00e7ea5cf1b32675413c32589b2c3e676e43ed422a1e666b1713bfb2e82ea`},
+}
+
+// ******** Test functions ********
+
+func TestHash256(t *testing.T) {
+	for _, v := range hash256Vectors {
+		checkHash(t, NewHash256(), sequence(v.messageLength, 0), v.expected)
+	}
+}
+
+func TestXOF128(t *testing.T) {
+	for _, v := range xof128Vectors {
+		checkHash(t, NewXOF128(katOutputLength), sequence(v.messageLength, 0), v.expected)
+	}
+}
+
+func TestCXOF128(t *testing.T) {
+	for _, v := range cxof128Vectors {
+		d, err := NewCXOF128(sequence(v.customizationLength, customizationStart), katOutputLength)
+		if err != nil {
+			t.Fatalf(`Customization length %d: unexpected error: %v`, v.customizationLength, err)
+		}
+
+		checkHash(t, d, sequence(v.messageLength, 0), v.expected)
+	}
+}
+
+func TestCXOF128CustomizationTooLong(t *testing.T) {
+	d, err := NewCXOF128(sequence(MaxCustomizationSize+1, customizationStart), katOutputLength)
+	if !errors.Is(err, ErrCustomizationTooLong) {
+		t.Fatalf(`Expected error '%v', got '%v'`, ErrCustomizationTooLong, err)
+	}
+
+	if d != nil {
+		t.Fatal(`Expected no digest for a too long customization string`)
+	}
+}
+
+func TestXOF128Read(t *testing.T) {
+	for _, v := range xof128Vectors {
+		d := NewXOF128(katOutputLength)
+		_, _ = d.Write(sequence(v.messageLength, 0))
+
+		output := make([]byte, 0, katOutputLength)
+		for len(output) < katOutputLength {
+			piece := make([]byte, min(5, katOutputLength-len(output)))
+			_, _ = d.Read(piece)
+			output = append(output, piece...)
+		}
+
+		got := hex.EncodeToString(output)
+		if got != v.expected {
+			t.Errorf(`Message length %d, read in pieces: got %s, expected %s`, v.messageLength, got, v.expected)
+		}
+	}
+}
+
+func TestXOF128OutputLength(t *testing.T) {
+	v := xof128Vectors[len(xof128Vectors)-1]
+
+	for _, outputLength := range []int{1, 7, 8, 9, 32} {
+		d := NewXOF128(outputLength)
+		_, _ = d.Write(sequence(v.messageLength, 0))
+
+		got := hex.EncodeToString(d.Sum(nil))
+		expected := v.expected[:outputLength<<1]
+		if got != expected {
+			t.Errorf(`Output length %d: got %s, expected %s`, outputLength, got, expected)
+		}
+	}
+}
+
+// ******** Private functions ********
+
+// checkHash checks the hash value of a message in one call and byte by byte.
+func checkHash(t *testing.T, h hash.Hash, message []byte, expected string) {
+	t.Helper()
+
+	_, _ = h.Write(message)
+
+	got := hex.EncodeToString(h.Sum(nil))
+	if got != expected {
+		t.Errorf(`Message length %d: got %s, expected %s`, len(message), got, expected)
+	}
+
+	h.Reset()
+	for _, b := range message {
+		_, _ = h.Write([]byte{b})
+	}
+
+	got = hex.EncodeToString(h.Sum(nil))
+	if got != expected {
+		t.Errorf(`Message length %d, written byte by byte: got %s, expected %s`, len(message), got, expected)
+	}
+}
+
+// sequence returns a byte slice of the given length with increasing byte values beginning at start.
+func sequence(length int, start byte) []byte {
+	result := make([]byte, length)
+	for i := range result {
+		result[i] = start + byte(i)
+	}
+
+	return result
+}
@@ -0,0 +1,168 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+// Package blake2 implements the BLAKE2b and BLAKE2s hash functions as specified in RFC 7693
+// and in https://www.blake2.net/blake2.pdf.
+//
+// The complete parameter block is supported: The digest size, a key, a salt, a personalization
+// and the parameters of the tree hashing mode.
+package blake2
+
+import (
+	"errors"
+)
+
+// ******** Public constants ********
+
+// These are the maximum sizes of BLAKE2b in bytes.
+const (
+	MaxSizeB             = 64
+	MaxKeySizeB          = 64
+	SaltSizeB            = 16
+	PersonalizationSizeB = 16
+	BlockSizeB           = 128
+)
+
+// These are the maximum sizes of BLAKE2s in bytes.
+const (
+	MaxSizeS             = 32
+	MaxKeySizeS          = 32
+	SaltSizeS            = 8
+	PersonalizationSizeS = 8
+	BlockSizeS           = 64
+)
+
+// ******** Public types ********
+
+// Parameters contains the parameter block of a BLAKE2 function.
+type Parameters struct {
+	// Size is the digest size in bytes.
+	Size int
+
+	// Key is the key. It is empty for an unkeyed hash.
+	Key []byte
+
+	// Salt is the salt. A salt shorter than the salt size is padded with zero bytes.
+	Salt []byte
+
+	// Personalization is the personalization string.
+	// A personalization shorter than the personalization size is padded with zero bytes.
+	Personalization []byte
+
+	// Tree contains the parameters of the tree hashing mode. If it is nil, the sequential mode is used.
+	Tree *TreeParameters
+}
+
+// TreeParameters contains the tree hashing parameters of a BLAKE2 function.
+type TreeParameters struct {
+	// FanOut is the maximum number of children of a node. 0 means unlimited.
+	FanOut uint8
+
+	// MaxDepth is the maximum depth of the tree. 255 means unlimited.
+	MaxDepth uint8
+
+	// LeafSize is the maximum number of bytes of a leaf. 0 means unlimited.
+	LeafSize uint32
+
+	// NodeOffset is the offset of the node. It must be smaller than 2^48 for BLAKE2s.
+	NodeOffset uint64
+
+	// NodeDepth is the depth of the node. Leaves have depth 0.
+	NodeDepth uint8
+
+	// InnerSize is the size of the inner hash values in bytes.
+	InnerSize uint8
+
+	// IsLastNode is true, if the node is the last node of its level.
+	IsLastNode bool
+}
+
+// ******** Public variables ********
+
+// These are the errors that are returned for invalid parameters.
+var (
+	ErrSize                = errors.New(`blake2: invalid digest size`)
+	ErrKeySize             = errors.New(`blake2: key is too long`)
+	ErrSaltSize            = errors.New(`blake2: salt is too long`)
+	ErrPersonalizationSize = errors.New(`blake2: personalization is too long`)
+	ErrNodeOffset          = errors.New(`blake2: node offset is too large`)
+	ErrInnerSize           = errors.New(`blake2: invalid inner hash size`)
+)
+
+// ******** Private variables ********
+
+// sequentialMode contains the tree parameters of the sequential mode.
+var sequentialMode = &TreeParameters{FanOut: 1, MaxDepth: 1}
+
+// sigma contains the message word permutations of the rounds.
+var sigma = [12][16]byte{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+	{14, 10, 4, 8, 9, 15, 13, 6, 1, 12, 0, 2, 11, 7, 5, 3},
+	{11, 8, 12, 0, 5, 2, 15, 13, 10, 14, 3, 6, 7, 1, 9, 4},
+	{7, 9, 3, 1, 13, 12, 11, 14, 2, 6, 5, 10, 4, 0, 15, 8},
+	{9, 0, 5, 7, 2, 4, 10, 15, 14, 1, 11, 12, 6, 8, 3, 13},
+	{2, 12, 6, 10, 0, 11, 8, 3, 4, 13, 7, 5, 15, 14, 1, 9},
+	{12, 5, 1, 15, 14, 13, 4, 10, 0, 7, 6, 3, 9, 2, 8, 11},
+	{13, 11, 7, 14, 12, 1, 3, 9, 5, 0, 15, 4, 8, 6, 2, 10},
+	{6, 15, 14, 9, 11, 3, 0, 8, 12, 2, 13, 7, 1, 4, 10, 5},
+	{10, 2, 8, 4, 7, 6, 1, 5, 15, 11, 9, 14, 3, 12, 13, 0},
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+	{14, 10, 4, 8, 9, 15, 13, 6, 1, 12, 0, 2, 11, 7, 5, 3},
+}
+
+// ******** Private functions ********
+
+// checkParameters checks the parameters against the maximum sizes of a BLAKE2 variant
+// and returns the tree parameters to use.
+func checkParameters(p *Parameters, maxSize int, maxKeySize int, saltSize int, personalizationSize int) (*TreeParameters, error) {
+	if p.Size < 1 || p.Size > maxSize {
+		return nil, ErrSize
+	}
+
+	if len(p.Key) > maxKeySize {
+		return nil, ErrKeySize
+	}
+
+	if len(p.Salt) > saltSize {
+		return nil, ErrSaltSize
+	}
+
+	if len(p.Personalization) > personalizationSize {
+		return nil, ErrPersonalizationSize
+	}
+
+	if p.Tree == nil {
+		return sequentialMode, nil
+	}
+
+	if int(p.Tree.InnerSize) > maxSize {
+		return nil, ErrInnerSize
+	}
+
+	return p.Tree, nil
+}
@@ -0,0 +1,275 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package blake2
+
+import (
+	"encoding/hex"
+	"errors"
+	"hash"
+	"testing"
+)
+
+// ******** Private types ********
+
+// katVector is a keyed test vector from the official BLAKE2 known answer tests.
+// The key has the maximum key size and is the sequence 00 01 02 ...
+// The input is the sequence 00 01 02 ... of the given length.
+type katVector struct {
+	variant     string
+	inputLength int
+	expected    string
+}
+
+// parameterVector is a test vector with a digest size, a salt, a personalization string or tree parameters.
+// The key and the input are the sequence 00 01 02 ... of the given lengths.
+type parameterVector struct {
+	variant         string
+	size            int
+	keyLength       int
+	salt            string
+	personalization string
+	tree            *TreeParameters
+	inputLength     int
+	expected        string
+}
+
+// invalidParameters are parameters that must be rejected.
+type invalidParameters struct {
+	variant       string
+	parameters    *Parameters
+	expectedError error
+}
+
+// ******** Private variables ********
+
+// katVectors contains test vectors from blake2b-kat.txt and blake2s-kat.txt
+// of the BLAKE2 reference implementation (https://github.com/BLAKE2/BLAKE2/tree/master/testvectors).
+var katVectors = []katVector{
+	{variant: `BLAKE2b`, inputLength: 0, expected: `10ebb67700b1868efb4417987acf4690ae9d972fb7a590c2f02871799aaa4786b5e996e8f0f4eb981fc214b005f42d2ff4233499391653df7aefcbc13fc51568`},
+	{variant: `BLAKE2b`, inputLength: 1, expected: `961f6dd1e4dd30f63901690c512e78e4b45e4742ed197c3c5e45c549fd25f2e4187b0bc9fe30492b16b0d0bc4ef9b0f34c7003fac09a5ef1532e69430234cebd`},
+	{variant: `BLAKE2b`, inputLength: 2, expected: `da2cfbe2d8409a0f38026113884f84b50156371ae304c4430173d08a99d9fb1b983164a3770706d537f49e0c916d9f32b95cc37a95b99d857436f0232c88a965`},
+	{variant: `BLAKE2b`, inputLength: 63, expected: `bd965bf31e87d70327536f2a341cebc4768eca275fa05ef98f7f1b71a0351298de006fba73fe6733ed01d75801b4a928e54231b38e38c562b2e33ea1284992fa`},
+	{variant: `BLAKE2b`, inputLength: 64, expected: `65676d800617972fbd87e4b9514e1c67402b7a331096d3bfac22f1abb95374abc942f16e9ab0ead33b87c91968a6e509e119ff07787b3ef483e1dcdccf6e3022`},
+	{variant: `BLAKE2b`, inputLength: 65, expected: `939fa189699c5d2c81ddd1ffc1fa207c970b6a3685bb29ce1d3e99d42f2f7442da53e95a72907314f4588399a3ff5b0a92beb3f6be2694f9f86ecf2952d5b41c`},
+	{variant: `BLAKE2b`, inputLength: 127, expected: `76d2d819c92bce55fa8e092ab1bf9b9eab237a25267986cacf2b8ee14d214d730dc9a5aa2d7b596e86a1fd8fa0804c77402d2fcd45083688b218b1cdfa0dcbcb`},
+	{variant: `BLAKE2b`, inputLength: 128, expected: `72065ee4dd91c2d8509fa1fc28a37c7fc9fa7d5b3f8ad3d0d7a25626b57b1b44788d4caf806290425f9890a3a2a35a905ab4b37acfd0da6e4517b2525c9651e4`},
+	{variant: `BLAKE2b`, inputLength: 129, expected: `64475dfe7600d7171bea0b394e27c9b00d8e74dd1e416a79473682ad3dfdbb706631558055cfc8a40e07bd015a4540dcdea15883cbbf31412df1de1cd4152b91`},
+	{variant: `BLAKE2b`, inputLength: 255, expected: `142709d62e28fcccd0af97fad0f8465b971e82201dc51070faa0372aa43e92484be1c1e73ba10906d5d1853db6a4106e0a7bf9800d373d6dee2d46d62ef2a461`},
+	{variant: `BLAKE2s`, inputLength: 0, expected: `48a8997da407876b3d79c0d92325ad3b89cbb754d86ab71aee047ad345fd2c49`},
+	{variant: `BLAKE2s`, inputLength: 1, expected: `40d15fee7c328830166ac3f918650f807e7e01e177258cdc0a39b11f598066f1`},
+	{variant: `BLAKE2s`, inputLength: 2, expected: `6bb71300644cd3991b26ccd4d274acd1adeab8b1d7914546c1198bbe9fc9d803`},
+	{variant: `BLAKE2s`, inputLength: 31, expected: `b6156f72d380ee9ea6acd190464f2307a5c179ef01fd71f99f2d0f7a57360aea`},
+	{variant: `BLAKE2s`, inputLength: 32, expected: `c03bc642b20959cbe133a0303e0c1abff3e31ec8e1a328ec8565c36decff5265`},
+	{variant: `BLAKE2s`, inputLength: 33, expected: `2c3e08176f760c6264c3a2cd66fec6c3d78de43fc192457b2a4a660a1e0eb22b`},
+	{variant: `BLAKE2s`, inputLength: 63, expected: `c65382513f07460da39833cb666c5ed82e61b9e998f4b0c4287cee56c3cc9bcd`},
+	{variant: `BLAKE2s`, inputLength: 64, expected: `8975b0577fd35566d750b362b0897a26c399136df07bababbde6203ff2954ed4`},
+	{variant: `BLAKE2s`, inputLength: 65, expected: `21fe0ceb0052be7fb0f004187cacd7de67fa6eb0938d927677f2398c132317a8`},
+	{variant: `BLAKE2s`, inputLength: 255, expected: `3fb735061abc519dfe979e54c1ee5bfad0a9d858b3315bad34bde999efd724dd`},
+}
+
+// parameterVectors contains test vectors that have been calculated with
+// the hashlib module of Python, which uses the BLAKE2 reference implementation.
+var parameterVectors = []parameterVector{
+	{
+		variant:         `BLAKE2b`,
+		size:            64,
+		salt:            `saltsaltsaltsalt`,
+		personalization: `personalization!`,
+		inputLength:     3,
+		expected:        `1befb2544982d18e41ea98920bab54be0736f249202048bd65f06dd64b39d3992a0981b66b2c542460048979c96f6fc7e957ee80204fe7770f55aa1faec3c526`,
+	},
+	{
+		variant:         `BLAKE2b`,
+		size:            32,
+		keyLength:       16,
+		salt:            `salt`,
+		personalization: `app`,
+		inputLength:     200,
+		expected:        `e1f3fb581e047d53afc95362ca2a827944fafc5a755f05fcef1a5e5064fe353a`,
+	},
+	{
+		variant:         `BLAKE2b`,
+		size:            20,
+		personalization: `My App`,
+		inputLength:     0,
+		expected:        `22a5f00521410c4ac8f7642da21374c0f0790fb1`,
+	},
+	{
+		variant:         `BLAKE2s`,
+		size:            32,
+		salt:            `saltsalt`,
+		personalization: `personal`,
+		inputLength:     3,
+		expected:        `637466cd513a674191a215cfd5610a5cfb11c4f5d830af134960e4dda729e324`,
+	},
+	{
+		variant:         `BLAKE2s`,
+		size:            16,
+		keyLength:       8,
+		salt:            `salt`,
+		personalization: `app`,
+		inputLength:     100,
+		expected:        `8358822fd323db680fe5d97591ec0a84`,
+	},
+	{
+		variant:     `BLAKE2b`,
+		size:        64,
+		tree:        &TreeParameters{FanOut: 2, MaxDepth: 2, LeafSize: 4096, NodeOffset: 5, NodeDepth: 1, InnerSize: 64, IsLastNode: true},
+		inputLength: 300,
+		expected:    `76d27eb46626786d789616fab485985ca48f1d6ebcdd9c05af85405b9cdbed536426e5fd1c8db2a5840aa87a67d90118d9c01389a4c3744d756c9bd9d492f3a0`,
+	},
+	{
+		variant:     `BLAKE2s`,
+		size:        32,
+		tree:        &TreeParameters{FanOut: 2, MaxDepth: 2, LeafSize: 4096, NodeOffset: maxNodeOffsetS, NodeDepth: 1, InnerSize: 32, IsLastNode: true},
+		inputLength: 300,
+		expected:    `38d337d39bc6c189476911f5a050cf8cfe0b4734ae3702cbc990605433156c96`,
+	},
+}
+
+// invalidParameterSets contains parameters that are not valid.
+var invalidParameterSets = []invalidParameters{
+	{variant: `BLAKE2b`, parameters: &Parameters{Size: 0}, expectedError: ErrSize},
+	{variant: `BLAKE2b`, parameters: &Parameters{Size: MaxSizeB + 1}, expectedError: ErrSize},
+	{variant: `BLAKE2b`, parameters: &Parameters{Size: MaxSizeB, Key: make([]byte, MaxKeySizeB+1)}, expectedError: ErrKeySize},
+	{variant: `BLAKE2b`, parameters: &Parameters{Size: MaxSizeB, Salt: make([]byte, SaltSizeB+1)}, expectedError: ErrSaltSize},
+	{variant: `BLAKE2b`, parameters: &Parameters{Size: MaxSizeB, Personalization: make([]byte, PersonalizationSizeB+1)}, expectedError: ErrPersonalizationSize},
+	{variant: `BLAKE2b`, parameters: &Parameters{Size: MaxSizeB, Tree: &TreeParameters{InnerSize: MaxSizeB + 1}}, expectedError: ErrInnerSize},
+	{variant: `BLAKE2s`, parameters: &Parameters{Size: 0}, expectedError: ErrSize},
+	{variant: `BLAKE2s`, parameters: &Parameters{Size: MaxSizeS + 1}, expectedError: ErrSize},
+	{variant: `BLAKE2s`, parameters: &Parameters{Size: MaxSizeS, Key: make([]byte, MaxKeySizeS+1)}, expectedError: ErrKeySize},
+	{variant: `BLAKE2s`, parameters: &Parameters{Size: MaxSizeS, Salt: make([]byte, SaltSizeS+1)}, expectedError: ErrSaltSize},
+	{variant: `BLAKE2s`, parameters: &Parameters{Size: MaxSizeS, Personalization: make([]byte, PersonalizationSizeS+1)}, expectedError: ErrPersonalizationSize},
+	{variant: `BLAKE2s`, parameters: &Parameters{Size: MaxSizeS, Tree: &TreeParameters{InnerSize: MaxSizeS + 1}}, expectedError: ErrInnerSize},
+	{variant: `BLAKE2s`, parameters: &Parameters{Size: MaxSizeS, Tree: &TreeParameters{NodeOffset: maxNodeOffsetS + 1}}, expectedError: ErrNodeOffset},
+}
+
+// ******** Test functions ********
+
+// TestRFC7693 tests the BLAKE2 functions with the test vectors from appendices A and B of RFC 7693.
+func TestRFC7693(t *testing.T) {
+	checkVector(t, `BLAKE2b`, &Parameters{Size: MaxSizeB}, []byte(`abc`),
+		`ba80a53f981c4d0d6a2797b69f12f6e94c212f14685ac4b74b12bb6fdbffa2d17d87c5392aab792dc252d5de4533cc9518d38aa8dbf1925ab92386edd4009923`)
+	checkVector(t, `BLAKE2s`, &Parameters{Size: MaxSizeS}, []byte(`abc`),
+		`508c5e8c327c14e2e1a72ba34eeb452f37458b209ed63a294d999b4c86675982`)
+}
+
+// TestKAT tests the BLAKE2 functions with the keyed known answer tests.
+func TestKAT(t *testing.T) {
+	for _, v := range katVectors {
+		p := &Parameters{Size: MaxSizeB, Key: sequence(MaxKeySizeB)}
+		if v.variant == `BLAKE2s` {
+			p = &Parameters{Size: MaxSizeS, Key: sequence(MaxKeySizeS)}
+		}
+
+		checkVector(t, v.variant, p, sequence(v.inputLength), v.expected)
+	}
+}
+
+// TestParameters tests the BLAKE2 functions with digest sizes, salts, personalization strings and tree parameters.
+func TestParameters(t *testing.T) {
+	for _, v := range parameterVectors {
+		p := &Parameters{
+			Size:            v.size,
+			Key:             sequence(v.keyLength),
+			Salt:            []byte(v.salt),
+			Personalization: []byte(v.personalization),
+			Tree:            v.tree,
+		}
+
+		checkVector(t, v.variant, p, sequence(v.inputLength), v.expected)
+	}
+}
+
+// TestInvalidParameters tests that invalid parameters are rejected.
+func TestInvalidParameters(t *testing.T) {
+	for i, v := range invalidParameterSets {
+		_, err := newDigest(v.variant, v.parameters)
+		if !errors.Is(err, v.expectedError) {
+			t.Errorf(`%s parameter set %d: got error %v, expected %v`, v.variant, i, err, v.expectedError)
+		}
+	}
+}
+
+// ******** Private functions ********
+
+// checkVector checks a test vector with the input written at once and byte by byte.
+func checkVector(t *testing.T, variant string, p *Parameters, input []byte, expected string) {
+	t.Helper()
+
+	h, err := newDigest(variant, p)
+	if err != nil {
+		t.Fatalf(`%s: unexpected error: %v`, variant, err)
+	}
+
+	_, _ = h.Write(input)
+	result := hex.EncodeToString(h.Sum(nil))
+	if result != expected {
+		t.Errorf(`%s of %d bytes: got %s, expected %s`, variant, len(input), result, expected)
+	}
+
+	h.Reset()
+	for i := range input {
+		_, _ = h.Write(input[i : i+1])
+	}
+
+	result = hex.EncodeToString(h.Sum(nil))
+	if result != expected {
+		t.Errorf(`%s of %d bytes byte by byte: got %s, expected %s`, variant, len(input), result, expected)
+	}
+}
+
+// newDigest creates a BLAKE2b or BLAKE2s function.
+func newDigest(variant string, p *Parameters) (hash.Hash, error) {
+	if variant == `BLAKE2s` {
+		d, err := NewS(p)
+		if err != nil {
+			return nil, err
+		}
+
+		return d, nil
+	}
+
+	d, err := NewB(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// sequence returns the sequence 00 01 02 ... of the given length.
+func sequence(length int) []byte {
+	result := make([]byte, length)
+	for i := range result {
+		result[i] = byte(i)
+	}
+
+	return result
+}
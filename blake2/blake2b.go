@@ -0,0 +1,218 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package blake2
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// ******** Private variables ********
+
+// ivB is the initialization vector of BLAKE2b. It is the same as the one of SHA-512.
+var ivB = [8]uint64{
+	0x6a09e667f3bcc908, 0xbb67ae8584caa73b, 0x3c6ef372fe94f82b, 0xa54ff53a5f1d36f1,
+	0x510e527fade682d1, 0x9b05688c2b3e6c1f, 0x1f83d9abfb41bd6b, 0x5be0cd19137e2179,
+}
+
+// ******** Public types ********
+
+// DigestB implements the BLAKE2b hash function.
+type DigestB struct {
+	state        [8]uint64
+	initialState [8]uint64
+	counter      [2]uint64
+	buffer       [BlockSizeB]byte
+	bufferedSize int
+	key          []byte
+	size         int
+	isLastNode   bool
+}
+
+// ******** Public functions ********
+
+// NewB creates a new BLAKE2b hash function with the given parameters.
+func NewB(p *Parameters) (*DigestB, error) {
+	tree, err := checkParameters(p, MaxSizeB, MaxKeySizeB, SaltSizeB, PersonalizationSizeB)
+	if err != nil {
+		return nil, err
+	}
+
+	var parameterBlock [64]byte
+	parameterBlock[0] = byte(p.Size)
+	parameterBlock[1] = byte(len(p.Key))
+	parameterBlock[2] = tree.FanOut
+	parameterBlock[3] = tree.MaxDepth
+	binary.LittleEndian.PutUint32(parameterBlock[4:], tree.LeafSize)
+	binary.LittleEndian.PutUint64(parameterBlock[8:], tree.NodeOffset)
+	parameterBlock[16] = tree.NodeDepth
+	parameterBlock[17] = tree.InnerSize
+	copy(parameterBlock[32:], p.Salt)
+	copy(parameterBlock[48:], p.Personalization)
+
+	d := &DigestB{
+		key:        append([]byte(nil), p.Key...),
+		size:       p.Size,
+		isLastNode: tree.IsLastNode,
+	}
+
+	for i := range d.initialState {
+		d.initialState[i] = ivB[i] ^ binary.LittleEndian.Uint64(parameterBlock[8*i:])
+	}
+
+	d.Reset()
+
+	return d, nil
+}
+
+// -------- DigestB methods --------
+
+// Write adds more data to the running hash.
+func (d *DigestB) Write(p []byte) (int, error) {
+	n := len(p)
+
+	// The last block is kept in the buffer, as it has to be processed with the final flag.
+	for len(p) > 0 {
+		if d.bufferedSize == BlockSizeB {
+			d.compress(d.buffer[:], BlockSizeB, 0, 0)
+			d.bufferedSize = 0
+		}
+
+		if d.bufferedSize == 0 {
+			for len(p) > BlockSizeB {
+				d.compress(p, BlockSizeB, 0, 0)
+				p = p[BlockSizeB:]
+			}
+		}
+
+		copied := copy(d.buffer[d.bufferedSize:], p)
+		d.bufferedSize += copied
+		p = p[copied:]
+	}
+
+	return n, nil
+}
+
+// Sum appends the current hash value to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (d *DigestB) Sum(b []byte) []byte {
+	// Work on a copy, so that the caller can keep writing.
+	c := *d
+
+	clear(c.buffer[c.bufferedSize:])
+
+	// The last node flag is only set for the last node of a level in the tree hashing mode.
+	var lastNodeFlag uint64
+	if c.isLastNode {
+		lastNodeFlag = ^uint64(0)
+	}
+
+	c.compress(c.buffer[:], c.bufferedSize, ^uint64(0), lastNodeFlag)
+
+	var out [MaxSizeB]byte
+	for i, v := range c.state {
+		binary.LittleEndian.PutUint64(out[8*i:], v)
+	}
+
+	return append(b, out[:c.size]...)
+}
+
+// Reset resets the hash to its initial state.
+func (d *DigestB) Reset() {
+	d.state = d.initialState
+	d.counter = [2]uint64{}
+	d.bufferedSize = 0
+
+	// A key is processed as a padded first block.
+	if len(d.key) != 0 {
+		clear(d.buffer[:])
+		copy(d.buffer[:], d.key)
+		d.bufferedSize = BlockSizeB
+	}
+}
+
+// Size returns the number of bytes Sum will return.
+func (d *DigestB) Size() int {
+	return d.size
+}
+
+// BlockSize returns the hash's underlying block size.
+func (d *DigestB) BlockSize() int {
+	return BlockSizeB
+}
+
+// compress processes one block that contains length data bytes with the given finalization flags.
+func (d *DigestB) compress(p []byte, length int, finalFlag uint64, lastNodeFlag uint64) {
+	var carry uint64
+	d.counter[0], carry = bits.Add64(d.counter[0], uint64(length), 0)
+	d.counter[1] += carry
+
+	var m [16]uint64
+	for i := range m {
+		m[i] = binary.LittleEndian.Uint64(p[8*i:])
+	}
+
+	var v [16]uint64
+	copy(v[:8], d.state[:])
+	copy(v[8:], ivB[:])
+	v[12] ^= d.counter[0]
+	v[13] ^= d.counter[1]
+	v[14] ^= finalFlag
+	v[15] ^= lastNodeFlag
+
+	for round := 0; round < 12; round++ {
+		s := &sigma[round]
+		gB(&v, 0, 4, 8, 12, m[s[0]], m[s[1]])
+		gB(&v, 1, 5, 9, 13, m[s[2]], m[s[3]])
+		gB(&v, 2, 6, 10, 14, m[s[4]], m[s[5]])
+		gB(&v, 3, 7, 11, 15, m[s[6]], m[s[7]])
+		gB(&v, 0, 5, 10, 15, m[s[8]], m[s[9]])
+		gB(&v, 1, 6, 11, 12, m[s[10]], m[s[11]])
+		gB(&v, 2, 7, 8, 13, m[s[12]], m[s[13]])
+		gB(&v, 3, 4, 9, 14, m[s[14]], m[s[15]])
+	}
+
+	for i := range d.state {
+		d.state[i] ^= v[i] ^ v[i+8]
+	}
+}
+
+// ******** Private functions ********
+
+// gB is the mixing function of BLAKE2b.
+func gB(v *[16]uint64, a int, b int, c int, d int, x uint64, y uint64) {
+	v[a] += v[b] + x
+	v[d] = bits.RotateLeft64(v[d]^v[a], -32)
+	v[c] += v[d]
+	v[b] = bits.RotateLeft64(v[b]^v[c], -24)
+	v[a] += v[b] + y
+	v[d] = bits.RotateLeft64(v[d]^v[a], -16)
+	v[c] += v[d]
+	v[b] = bits.RotateLeft64(v[b]^v[c], -63)
+}
@@ -0,0 +1,226 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package blake2
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// ******** Private constants ********
+
+// maxNodeOffsetS is the maximum node offset of BLAKE2s, which has only 48 bits.
+const maxNodeOffsetS = 1<<48 - 1
+
+// ******** Private variables ********
+
+// ivS is the initialization vector of BLAKE2s. It is the same as the one of SHA-256.
+var ivS = [8]uint32{
+	0x6a09e667, 0xbb67ae85, 0x3c6ef372, 0xa54ff53a,
+	0x510e527f, 0x9b05688c, 0x1f83d9ab, 0x5be0cd19,
+}
+
+// ******** Public types ********
+
+// DigestS implements the BLAKE2s hash function.
+type DigestS struct {
+	state        [8]uint32
+	initialState [8]uint32
+	counter      uint64
+	buffer       [BlockSizeS]byte
+	bufferedSize int
+	key          []byte
+	size         int
+	isLastNode   bool
+}
+
+// ******** Public functions ********
+
+// NewS creates a new BLAKE2s hash function with the given parameters.
+func NewS(p *Parameters) (*DigestS, error) {
+	tree, err := checkParameters(p, MaxSizeS, MaxKeySizeS, SaltSizeS, PersonalizationSizeS)
+	if err != nil {
+		return nil, err
+	}
+
+	if tree.NodeOffset > maxNodeOffsetS {
+		return nil, ErrNodeOffset
+	}
+
+	var parameterBlock [32]byte
+	parameterBlock[0] = byte(p.Size)
+	parameterBlock[1] = byte(len(p.Key))
+	parameterBlock[2] = tree.FanOut
+	parameterBlock[3] = tree.MaxDepth
+	binary.LittleEndian.PutUint32(parameterBlock[4:], tree.LeafSize)
+	binary.LittleEndian.PutUint32(parameterBlock[8:], uint32(tree.NodeOffset))
+	binary.LittleEndian.PutUint16(parameterBlock[12:], uint16(tree.NodeOffset>>32))
+	parameterBlock[14] = tree.NodeDepth
+	parameterBlock[15] = tree.InnerSize
+	copy(parameterBlock[16:], p.Salt)
+	copy(parameterBlock[24:], p.Personalization)
+
+	d := &DigestS{
+		key:        append([]byte(nil), p.Key...),
+		size:       p.Size,
+		isLastNode: tree.IsLastNode,
+	}
+
+	for i := range d.initialState {
+		d.initialState[i] = ivS[i] ^ binary.LittleEndian.Uint32(parameterBlock[4*i:])
+	}
+
+	d.Reset()
+
+	return d, nil
+}
+
+// -------- DigestS methods --------
+
+// Write adds more data to the running hash.
+func (d *DigestS) Write(p []byte) (int, error) {
+	n := len(p)
+
+	// The last block is kept in the buffer, as it has to be processed with the final flag.
+	for len(p) > 0 {
+		if d.bufferedSize == BlockSizeS {
+			d.compress(d.buffer[:], BlockSizeS, 0, 0)
+			d.bufferedSize = 0
+		}
+
+		if d.bufferedSize == 0 {
+			for len(p) > BlockSizeS {
+				d.compress(p, BlockSizeS, 0, 0)
+				p = p[BlockSizeS:]
+			}
+		}
+
+		copied := copy(d.buffer[d.bufferedSize:], p)
+		d.bufferedSize += copied
+		p = p[copied:]
+	}
+
+	return n, nil
+}
+
+// Sum appends the current hash value to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (d *DigestS) Sum(b []byte) []byte {
+	// Work on a copy, so that the caller can keep writing.
+	c := *d
+
+	clear(c.buffer[c.bufferedSize:])
+
+	// The last node flag is only set for the last node of a level in the tree hashing mode.
+	var lastNodeFlag uint32
+	if c.isLastNode {
+		lastNodeFlag = ^uint32(0)
+	}
+
+	c.compress(c.buffer[:], c.bufferedSize, ^uint32(0), lastNodeFlag)
+
+	var out [MaxSizeS]byte
+	for i, v := range c.state {
+		binary.LittleEndian.PutUint32(out[4*i:], v)
+	}
+
+	return append(b, out[:c.size]...)
+}
+
+// Reset resets the hash to its initial state.
+func (d *DigestS) Reset() {
+	d.state = d.initialState
+	d.counter = 0
+	d.bufferedSize = 0
+
+	// A key is processed as a padded first block.
+	if len(d.key) != 0 {
+		clear(d.buffer[:])
+		copy(d.buffer[:], d.key)
+		d.bufferedSize = BlockSizeS
+	}
+}
+
+// Size returns the number of bytes Sum will return.
+func (d *DigestS) Size() int {
+	return d.size
+}
+
+// BlockSize returns the hash's underlying block size.
+func (d *DigestS) BlockSize() int {
+	return BlockSizeS
+}
+
+// compress processes one block that contains length data bytes with the given finalization flags.
+func (d *DigestS) compress(p []byte, length int, finalFlag uint32, lastNodeFlag uint32) {
+	d.counter += uint64(length)
+
+	var m [16]uint32
+	for i := range m {
+		m[i] = binary.LittleEndian.Uint32(p[4*i:])
+	}
+
+	var v [16]uint32
+	copy(v[:8], d.state[:])
+	copy(v[8:], ivS[:])
+	v[12] ^= uint32(d.counter)
+	v[13] ^= uint32(d.counter >> 32)
+	v[14] ^= finalFlag
+	v[15] ^= lastNodeFlag
+
+	for round := 0; round < 10; round++ {
+		s := &sigma[round]
+		gS(&v, 0, 4, 8, 12, m[s[0]], m[s[1]])
+		gS(&v, 1, 5, 9, 13, m[s[2]], m[s[3]])
+		gS(&v, 2, 6, 10, 14, m[s[4]], m[s[5]])
+		gS(&v, 3, 7, 11, 15, m[s[6]], m[s[7]])
+		gS(&v, 0, 5, 10, 15, m[s[8]], m[s[9]])
+		gS(&v, 1, 6, 11, 12, m[s[10]], m[s[11]])
+		gS(&v, 2, 7, 8, 13, m[s[12]], m[s[13]])
+		gS(&v, 3, 4, 9, 14, m[s[14]], m[s[15]])
+	}
+
+	for i := range d.state {
+		d.state[i] ^= v[i] ^ v[i+8]
+	}
+}
+
+// ******** Private functions ********
+
+// gS is the mixing function of BLAKE2s.
+func gS(v *[16]uint32, a int, b int, c int, d int, x uint32, y uint32) {
+	v[a] += v[b] + x
+	v[d] = bits.RotateLeft32(v[d]^v[a], -16)
+	v[c] += v[d]
+	v[b] = bits.RotateLeft32(v[b]^v[c], -12)
+	v[a] += v[b] + y
+	v[d] = bits.RotateLeft32(v[d]^v[a], -8)
+	v[c] += v[d]
+	v[b] = bits.RotateLeft32(v[b]^v[c], -7)
+}
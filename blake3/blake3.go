@@ -0,0 +1,470 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+// Package blake3 implements the BLAKE3 hash function as specified in
+// https://github.com/BLAKE3-team/BLAKE3-specs/blob/master/blake3.pdf.
+//
+// It supports the hash, keyed hash and key derivation modes with arbitrary output lengths.
+// Large inputs are hashed in parallel.
+package blake3
+
+import (
+	"encoding/binary"
+	"errors"
+	"runtime"
+	"sync"
+)
+
+// ******** Public constants ********
+
+// Size is the default size of a BLAKE3 hash value in bytes.
+const Size = 32
+
+// KeySize is the size of a BLAKE3 key in bytes.
+const KeySize = 32
+
+// BlockSize is the block size of BLAKE3 in bytes.
+const BlockSize = 64
+
+// ******** Public variables ********
+
+// ErrKeySize is returned when a key does not have the size KeySize.
+var ErrKeySize = errors.New(`blake3: key must be 32 bytes long`)
+
+// ******** Private constants ********
+
+// chunkLen is the length of a chunk in bytes.
+const chunkLen = 1024
+
+// minChunksPerWorker is the minimum number of chunks a goroutine hashes when chunks are hashed in parallel.
+const minChunksPerWorker = 16
+
+// These are the domain separation flags.
+const (
+	flagChunkStart uint32 = 1 << iota
+	flagChunkEnd
+	flagParent
+	flagRoot
+	flagKeyedHash
+	flagDeriveKeyContext
+	flagDeriveKeyMaterial
+)
+
+// ******** Private variables ********
+
+// iv is the initialization vector. It is the same as the one of SHA-256.
+var iv = [8]uint32{
+	0x6a09e667, 0xbb67ae85, 0x3c6ef372, 0xa54ff53a,
+	0x510e527f, 0x9b05688c, 0x1f83d9ab, 0x5be0cd19,
+}
+
+// messagePermutation is the permutation of the message words between the rounds.
+var messagePermutation = [16]int{2, 6, 3, 10, 7, 0, 4, 13, 1, 11, 12, 5, 9, 14, 15, 8}
+
+// ******** Public types ********
+
+// Hasher implements the BLAKE3 hash function.
+type Hasher struct {
+	key          [8]uint32
+	flags        uint32
+	chunk        chunkState
+	cvStack      [][8]uint32
+	outputLength int
+}
+
+// ******** Private types ********
+
+// chunkState is the state of the chunk that is currently hashed.
+type chunkState struct {
+	cv               [8]uint32
+	chunkCounter     uint64
+	block            [BlockSize]byte
+	blockLen         int
+	blocksCompressed int
+	flags            uint32
+}
+
+// output contains the input of a compression that may produce a chaining value or the root output.
+type output struct {
+	inputCV  [8]uint32
+	block    [16]uint32
+	counter  uint64
+	blockLen uint32
+	flags    uint32
+}
+
+// ******** Public functions ********
+
+// New creates a new BLAKE3 hash function with the given output length in bytes.
+// If the output length is 0, the default size is used.
+func New(outputLength int) *Hasher {
+	return newHasher(iv, 0, outputLength)
+}
+
+// NewKeyed creates a new keyed BLAKE3 hash function with the given output length in bytes.
+// The key must be KeySize bytes long.
+// If the output length is 0, the default size is used.
+func NewKeyed(key []byte, outputLength int) (*Hasher, error) {
+	if len(key) != KeySize {
+		return nil, ErrKeySize
+	}
+
+	return newHasher(keyWords(key), flagKeyedHash, outputLength), nil
+}
+
+// NewDeriveKey creates a new BLAKE3 key derivation function for the given context
+// with the given output length in bytes. The key material is the data written to the hash function.
+// If the output length is 0, the default size is used.
+func NewDeriveKey(context []byte, outputLength int) *Hasher {
+	contextHasher := newHasher(iv, flagDeriveKeyContext, KeySize)
+	_, _ = contextHasher.Write(context)
+	contextKey := contextHasher.Sum(nil)
+
+	return newHasher(keyWords(contextKey), flagDeriveKeyMaterial, outputLength)
+}
+
+// -------- Hasher methods --------
+
+// Write adds more data to the running hash.
+func (h *Hasher) Write(p []byte) (int, error) {
+	n := len(p)
+
+	for len(p) > 0 {
+		// A full chunk is only finished when more data is known to follow, as the last chunk may be the root.
+		if h.chunk.len() == chunkLen {
+			totalChunks := h.chunk.chunkCounter + 1
+			h.addChunkChainingValue(h.chunk.output().chainingValue(), totalChunks)
+			h.chunk = newChunkState(h.key, totalChunks, h.flags)
+		}
+
+		// Whole chunks that are followed by more data are hashed in parallel.
+		if h.chunk.len() == 0 && len(p) > chunkLen {
+			chunkCount := (len(p) - 1) / chunkLen
+			h.hashChunks(p[:chunkCount*chunkLen])
+			p = p[chunkCount*chunkLen:]
+
+			continue
+		}
+
+		take := min(chunkLen-h.chunk.len(), len(p))
+		h.chunk.update(p[:take])
+		p = p[take:]
+	}
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (h *Hasher) Sum(b []byte) []byte {
+	o := h.chunk.output()
+	for i := len(h.cvStack) - 1; i >= 0; i-- {
+		o = parentOutput(h.cvStack[i], o.chainingValue(), h.key, h.flags)
+	}
+
+	result := append(b, make([]byte, h.outputLength)...)
+	o.rootBytes(result[len(b):])
+
+	return result
+}
+
+// Reset resets the hash to its initial state.
+func (h *Hasher) Reset() {
+	h.chunk = newChunkState(h.key, 0, h.flags)
+	h.cvStack = h.cvStack[:0]
+}
+
+// Size returns the number of bytes Sum will return.
+func (h *Hasher) Size() int {
+	return h.outputLength
+}
+
+// BlockSize returns the hash's underlying block size.
+func (h *Hasher) BlockSize() int {
+	return BlockSize
+}
+
+// ******** Private functions ********
+
+// newHasher creates a new Hasher with the given key words, flags and output length.
+func newHasher(key [8]uint32, flags uint32, outputLength int) *Hasher {
+	if outputLength == 0 {
+		outputLength = Size
+	}
+
+	return &Hasher{
+		key:          key,
+		flags:        flags,
+		chunk:        newChunkState(key, 0, flags),
+		cvStack:      make([][8]uint32, 0, 54),
+		outputLength: outputLength,
+	}
+}
+
+// hashChunks hashes whole chunks, which are known not to be the last chunks, in parallel
+// and adds their chaining values to the tree.
+func (h *Hasher) hashChunks(data []byte) {
+	chunkCount := len(data) / chunkLen
+	firstCounter := h.chunk.chunkCounter
+	cvs := make([][8]uint32, chunkCount)
+
+	hashRange := func(first int, last int) {
+		for i := first; i < last; i++ {
+			c := newChunkState(h.key, firstCounter+uint64(i), h.flags)
+			c.update(data[i*chunkLen : (i+1)*chunkLen])
+			cvs[i] = c.output().chainingValue()
+		}
+	}
+
+	workerCount := min(runtime.NumCPU(), chunkCount/minChunksPerWorker)
+	if workerCount > 1 {
+		chunksPerWorker := (chunkCount + workerCount - 1) / workerCount
+
+		var wg sync.WaitGroup
+		for first := 0; first < chunkCount; first += chunksPerWorker {
+			wg.Add(1)
+			go func(first int) {
+				defer wg.Done()
+				hashRange(first, min(first+chunksPerWorker, chunkCount))
+			}(first)
+		}
+
+		wg.Wait()
+	} else {
+		hashRange(0, chunkCount)
+	}
+
+	for i, cv := range cvs {
+		h.addChunkChainingValue(cv, firstCounter+uint64(i)+1)
+	}
+
+	h.chunk = newChunkState(h.key, firstCounter+uint64(chunkCount), h.flags)
+}
+
+// addChunkChainingValue adds the chaining value of a chunk to the tree.
+// All subtrees that are completed by this chunk are merged into parent nodes.
+func (h *Hasher) addChunkChainingValue(cv [8]uint32, totalChunks uint64) {
+	for totalChunks&1 == 0 {
+		last := len(h.cvStack) - 1
+		cv = parentOutput(h.cvStack[last], cv, h.key, h.flags).chainingValue()
+		h.cvStack = h.cvStack[:last]
+		totalChunks >>= 1
+	}
+
+	h.cvStack = append(h.cvStack, cv)
+}
+
+// -------- chunkState functions --------
+
+// newChunkState creates a new chunk state.
+func newChunkState(key [8]uint32, chunkCounter uint64, flags uint32) chunkState {
+	return chunkState{
+		cv:           key,
+		chunkCounter: chunkCounter,
+		flags:        flags,
+	}
+}
+
+// len returns the number of bytes that have been written to the chunk.
+func (c *chunkState) len() int {
+	return BlockSize*c.blocksCompressed + c.blockLen
+}
+
+// startFlag returns the chunk start flag if no block has been compressed, yet.
+func (c *chunkState) startFlag() uint32 {
+	if c.blocksCompressed == 0 {
+		return flagChunkStart
+	}
+
+	return 0
+}
+
+// update adds data to the chunk. The data must fit into the chunk.
+func (c *chunkState) update(p []byte) {
+	for len(p) > 0 {
+		// A full block is only compressed when more data is known to follow, as the last block is special.
+		if c.blockLen == BlockSize {
+			block := blockWords(&c.block)
+			out := compress(&c.cv, &block, c.chunkCounter, BlockSize, c.flags|c.startFlag())
+			copy(c.cv[:], out[:8])
+			c.blocksCompressed++
+			c.block = [BlockSize]byte{}
+			c.blockLen = 0
+		}
+
+		n := copy(c.block[c.blockLen:], p)
+		c.blockLen += n
+		p = p[n:]
+	}
+}
+
+// output returns the output of the chunk.
+func (c *chunkState) output() *output {
+	return &output{
+		inputCV:  c.cv,
+		block:    blockWords(&c.block),
+		counter:  c.chunkCounter,
+		blockLen: uint32(c.blockLen),
+		flags:    c.flags | c.startFlag() | flagChunkEnd,
+	}
+}
+
+// -------- output functions --------
+
+// parentOutput returns the output of a parent node.
+func parentOutput(left [8]uint32, right [8]uint32, key [8]uint32, flags uint32) *output {
+	result := &output{
+		inputCV:  key,
+		counter:  0,
+		blockLen: BlockSize,
+		flags:    flags | flagParent,
+	}
+
+	copy(result.block[:8], left[:])
+	copy(result.block[8:], right[:])
+
+	return result
+}
+
+// chainingValue returns the chaining value of the output.
+func (o *output) chainingValue() [8]uint32 {
+	out := compress(&o.inputCV, &o.block, o.counter, o.blockLen, o.flags)
+
+	var result [8]uint32
+	copy(result[:], out[:8])
+
+	return result
+}
+
+// rootBytes fills out with the root output bytes.
+func (o *output) rootBytes(out []byte) {
+	var counter uint64
+	var buffer [BlockSize]byte
+
+	for len(out) > 0 {
+		words := compress(&o.inputCV, &o.block, counter, o.blockLen, o.flags|flagRoot)
+		for i, w := range words {
+			binary.LittleEndian.PutUint32(buffer[i<<2:], w)
+		}
+
+		n := copy(out, buffer[:])
+		out = out[n:]
+		counter++
+	}
+}
+
+// -------- Compression functions --------
+
+// compress is the BLAKE3 compression function.
+func compress(cv *[8]uint32, block *[16]uint32, counter uint64, blockLen uint32, flags uint32) [16]uint32 {
+	v := [16]uint32{
+		cv[0], cv[1], cv[2], cv[3], cv[4], cv[5], cv[6], cv[7],
+		iv[0], iv[1], iv[2], iv[3],
+		uint32(counter), uint32(counter >> 32), blockLen, flags,
+	}
+
+	m := *block
+	for r := 0; r < 7; r++ {
+		round(&v, &m)
+
+		if r < 6 {
+			permute(&m)
+		}
+	}
+
+	for i := 0; i < 8; i++ {
+		v[i] ^= v[i+8]
+		v[i+8] ^= cv[i]
+	}
+
+	return v
+}
+
+// round is one round of the compression function.
+func round(v *[16]uint32, m *[16]uint32) {
+	// Mix the columns.
+	g(v, 0, 4, 8, 12, m[0], m[1])
+	g(v, 1, 5, 9, 13, m[2], m[3])
+	g(v, 2, 6, 10, 14, m[4], m[5])
+	g(v, 3, 7, 11, 15, m[6], m[7])
+
+	// Mix the diagonals.
+	g(v, 0, 5, 10, 15, m[8], m[9])
+	g(v, 1, 6, 11, 12, m[10], m[11])
+	g(v, 2, 7, 8, 13, m[12], m[13])
+	g(v, 3, 4, 9, 14, m[14], m[15])
+}
+
+// g is the quarter-round function.
+func g(v *[16]uint32, a int, b int, c int, d int, mx uint32, my uint32) {
+	v[a] += v[b] + mx
+	v[d] = rotateRight(v[d]^v[a], 16)
+	v[c] += v[d]
+	v[b] = rotateRight(v[b]^v[c], 12)
+	v[a] += v[b] + my
+	v[d] = rotateRight(v[d]^v[a], 8)
+	v[c] += v[d]
+	v[b] = rotateRight(v[b]^v[c], 7)
+}
+
+// permute permutes the message words.
+func permute(m *[16]uint32) {
+	var permuted [16]uint32
+	for i, j := range messagePermutation {
+		permuted[i] = m[j]
+	}
+
+	*m = permuted
+}
+
+// rotateRight rotates x to the right by n bits.
+func rotateRight(x uint32, n int) uint32 {
+	return (x >> n) | (x << (32 - n))
+}
+
+// -------- Conversion functions --------
+
+// blockWords converts a block to little-endian words.
+func blockWords(block *[BlockSize]byte) [16]uint32 {
+	var result [16]uint32
+	for i := range result {
+		result[i] = binary.LittleEndian.Uint32(block[i<<2:])
+	}
+
+	return result
+}
+
+// keyWords converts a key to little-endian words.
+func keyWords(key []byte) [8]uint32 {
+	var result [8]uint32
+	for i := range result {
+		result[i] = binary.LittleEndian.Uint32(key[i<<2:])
+	}
+
+	return result
+}
@@ -1,5 +1,5 @@
 //
-// SPDX-FileCopyrightText: Copyright 2024-2025 Frank Schwab
+// SPDX-FileCopyrightText: Copyright 2024-2026 Frank Schwab
 //
 // SPDX-License-Identifier: Apache-2.0
 //
@@ -20,7 +20,7 @@
 //
 // Author: Frank Schwab
 //
-// Version: 3.1.0
+// Version: 4.14.5
 //
 // Change history:
 //    2024-12-29: V1.0.0: Created.
@@ -28,6 +28,32 @@
 //    2025-02-26: V2.0.0: No more headers. Allow only one encoding.
 //    2025-03-02: V3.0.0: New command line structure. Ability to process hex bytes.
 //    2025-04-17: V3.1.0: Change "hash type" to "hash algorithm". No default hash algorithm.
+//    2026-10-16: V3.2.0: Add key options for HMAC calculation.
+//    2026-10-16: V3.2.1: Keys are used for all keyed hashes, not only for HMACs.
+//    2026-10-16: V3.3.0: Add output length option.
+//    2026-10-16: V3.4.0: Add customization and function name options.
+//    2026-10-16: V4.0.0: Sources may consist of several parts. Add block size option.
+//    2026-10-16: V4.1.0: Add context option.
+//    2026-10-16: V4.2.0: Add decimal encoding. Show non-cryptographic checksums separately.
+//    2026-10-16: V4.3.0: Add CRC parameters option.
+//    2026-10-16: V4.4.0: Add seed option.
+//    2026-10-16: V4.4.1: Seed is also used by MurmurHash3.
+//    2026-10-16: V4.5.0: Customization is also used by KangarooTwelve.
+//    2026-10-16: V4.5.1: Customization is also used by Ascon-CXOF128.
+//    2026-10-16: V4.6.0: Add salt and personalization options.
+//    2026-10-16: V4.7.0: Add nonce options.
+//    2026-10-16: V4.8.0: Add list options.
+//    2026-10-16: V4.9.0: Mention aliases in usage.
+//    2026-10-16: V4.10.0: Add password hashing options.
+//    2026-10-16: V4.11.0: Add password verification option.
+//    2026-10-16: V4.12.0: Mention SHA-crypt in usage of iterations.
+//    2026-10-16: V4.13.0: Add HKDF options.
+//    2026-10-16: V4.14.0: Add DRBG options and raw encoding.
+//    2026-10-16: V4.14.1: Correct block size error message.
+//    2026-10-16: V4.14.2: Use lower case placeholder for the number of iterations.
+//    2026-10-16: V4.14.3: Mention HKDF in usage of salt.
+//    2026-10-16: V4.14.4: Mention DRBGs in usage of personalization and nonce. Use lower case placeholder for count.
+//    2026-10-16: V4.14.5: Use the maximum block size of the hash factory.
 //
 
 package main
@@ -38,7 +64,11 @@ import (
 	"fmt"
 	"hashvalue/encodedprinting"
 	"hashvalue/hashfactory"
+	"hashvalue/password"
 	"hashvalue/stringhelper"
+	"os"
+	"slices"
+	"strconv"
 	"strings"
 )
 
@@ -53,18 +83,90 @@ const maxHexParameterLen = 8
 // errFmtIsEmpty is the error string for an empty variable.
 const errFmtIsEmpty = `%s is empty`
 
+// maxOutputLength is the maximum output length in bytes.
+const maxOutputLength = 1024 * 1024
+
+// encodingRaw is the encoding type that prints bytes without any encoding.
+const encodingRaw = `raw`
+
 // ******** Private variables ********
 
 // Option presence flags.
 
-// haveSource is true if the 'source' option has been set.
-var haveSource = false
+// haveKey is true if the 'key' option has been set.
+var haveKey = false
+
+// haveHexKey is true if the 'hexkey' option has been set.
+var haveHexKey = false
+
+// haveKeyFile is true if the 'keyfile' option has been set.
+var haveKeyFile = false
+
+// haveSalt is true if the 'salt' option has been set.
+var haveSalt = false
+
+// haveHexSalt is true if the 'hexsalt' option has been set.
+var haveHexSalt = false
+
+// havePersonalization is true if the 'personalization' option has been set.
+var havePersonalization = false
+
+// haveHexPersonalization is true if the 'hexpersonalization' option has been set.
+var haveHexPersonalization = false
+
+// haveNonce is true if the 'nonce' option has been set.
+var haveNonce = false
+
+// haveHexNonce is true if the 'hexnonce' option has been set.
+var haveHexNonce = false
+
+// haveIKM is true if the 'ikm' option has been set.
+var haveIKM = false
+
+// haveHexIKM is true if the 'hexikm' option has been set.
+var haveHexIKM = false
+
+// haveInfo is true if the 'info' option has been set.
+var haveInfo = false
+
+// haveHexInfo is true if the 'hexinfo' option has been set.
+var haveHexInfo = false
+
+// haveEntropy is true if the 'entropy' option has been set.
+var haveEntropy = false
+
+// haveHexEntropy is true if the 'hexentropy' option has been set.
+var haveHexEntropy = false
+
+// haveReseed is true if the 'reseed' option has been set.
+var haveReseed = false
+
+// haveHexReseed is true if the 'hexreseed' option has been set.
+var haveHexReseed = false
 
-// haveHexSource is true if the 'hexsource' option has been set.
-var haveHexSource = false
+// haveEncoding is true if the 'encoding' option has been set.
+var haveEncoding = false
 
-// haveFile is true if the 'file' option has been set.
-var haveFile = false
+// hashOnlyOptionsSet contains the names of the options that have been set and that are not used for password hashing.
+var hashOnlyOptionsSet []string
+
+// passwordOnlyOptionsSet contains the names of the options that have been set and that are only used for password hashing.
+var passwordOnlyOptionsSet []string
+
+// verifyIncompatibleOptionsSet contains the names of the options that have been set and that are not used for password verification.
+var verifyIncompatibleOptionsSet []string
+
+// hkdfOnlyOptionsSet contains the names of the options that have been set and that are only used for HKDF.
+var hkdfOnlyOptionsSet []string
+
+// hkdfIncompatibleOptionsSet contains the names of the options that have been set and that are not used for HKDF.
+var hkdfIncompatibleOptionsSet []string
+
+// drbgOnlyOptionsSet contains the names of the options that have been set and that are only used for DRBGs.
+var drbgOnlyOptionsSet []string
+
+// drbgIncompatibleOptionsSet contains the names of the options that have been set and that are not used for DRBGs.
+var drbgIncompatibleOptionsSet []string
 
 // Option values.
 
@@ -74,14 +176,119 @@ var haveFile = false
 // hashAlgorithm is the name of the hash.
 var hashAlgorithm string
 
-// source is the source text to hash.
-var source string
+// crcParameters is the parameter specification of a custom CRC.
+var crcParameters string
+
+// passwordHashAlgorithm is the name of the password hash algorithm.
+var passwordHashAlgorithm string
+
+// iterations is the number of iterations of a password hash.
+var iterations int
+
+// memory is the memory size in KiB of a password hash.
+var memory int
+
+// parallelism is the degree of parallelism of a password hash.
+var parallelism int
+
+// cost is the binary logarithm of the cost of a password hash.
+var cost int
+
+// blockFactor is the block size factor of a password hash.
+var blockFactor int
+
+// verifyPassword is the encoded password hash that the password is verified against.
+var verifyPassword string
+
+// usePHC indicates that a password hash should be printed as a PHC or modular crypt string.
+var usePHC bool
+
+// hkdfAlgorithm is the name of the hash algorithm of HKDF.
+var hkdfAlgorithm string
+
+// ikm is the input keying material text of HKDF.
+var ikm string
+
+// hexIKM is the input keying material text of HKDF in hex encoding.
+var hexIKM string
 
-// hexSource is the source text to hash in hex encoding.
-var hexSource string
+// info is the info text of HKDF.
+var info string
 
-// fileName is the name of the file whose contents are to be hashed.
-var fileName string
+// hexInfo is the info text of HKDF in hex encoding.
+var hexInfo string
+
+// extractOnly indicates that only the extract step of HKDF should be performed.
+var extractOnly bool
+
+// expandOnly indicates that only the expand step of HKDF should be performed.
+var expandOnly bool
+
+// hashDRBGAlgorithm is the name of the hash algorithm of a Hash_DRBG.
+var hashDRBGAlgorithm string
+
+// hmacDRBGAlgorithm is the name of the hash algorithm of a HMAC_DRBG.
+var hmacDRBGAlgorithm string
+
+// entropy is the entropy input text of a DRBG.
+var entropy string
+
+// hexEntropy is the entropy input text of a DRBG in hex encoding.
+var hexEntropy string
+
+// reseed is the entropy input text of a DRBG reseed.
+var reseed string
+
+// hexReseed is the entropy input text of a DRBG reseed in hex encoding.
+var hexReseed string
+
+// count is the number of bytes that a DRBG generates.
+var count int64
+
+// key is the key text for a keyed hash.
+var key string
+
+// hexKey is the key text for a keyed hash in hex encoding.
+var hexKey string
+
+// keyFileName is the name of the file that contains the key for a keyed hash.
+var keyFileName string
+
+// lengthText is the text of the output length.
+var lengthText string
+
+// customization is the customization string for customizable hash functions.
+var customization string
+
+// functionName is the function name string for customizable hash functions.
+var functionName string
+
+// context is the context string for key derivation functions.
+var context string
+
+// blockSize is the block size in bytes for hash functions that hash blocks in parallel.
+var blockSize int
+
+// seed is the seed for seeded non-cryptographic hash functions.
+var seed uint64
+
+// salt is the salt text for salted hash functions.
+var salt string
+
+// hexSalt is the salt text for salted hash functions in hex encoding.
+var hexSalt string
+
+// personalization is the personalization text for personalized hash functions.
+var personalization string
+
+// hexPersonalization is the personalization text for personalized hash functions in hex encoding.
+var hexPersonalization string
+
+// nonce is the nonce text for message authentication codes that need a nonce.
+var nonce string
+
+// hexNonce is the nonce text for message authentication codes that need a nonce in hex encoding.
+var hexNonce string
 
 // encodingType specifies the output encoding to use.
 var encodingType string
@@ -103,8 +310,47 @@ var useUpper bool
 // showVersion indicates that the version information should be printed.
 var showVersion bool
 
-// sourceBytes contains the bytes of the source.
-var sourceBytes []byte
+// listAlgorithms indicates that the list of algorithms should be printed.
+var listAlgorithms bool
+
+// listFormat is the format of the list of algorithms.
+var listFormat string
+
+// keyBytes contains the bytes of the key.
+// It is nil, if no key has been specified.
+var keyBytes []byte
+
+// saltBytes contains the bytes of the salt.
+// It is nil, if no salt has been specified.
+var saltBytes []byte
+
+// personalizationBytes contains the bytes of the personalization.
+// It is nil, if no personalization has been specified.
+var personalizationBytes []byte
+
+// ikmBytes contains the bytes of the input keying material of HKDF.
+// It is nil, if no input keying material has been specified.
+var ikmBytes []byte
+
+// infoBytes contains the bytes of the info of HKDF.
+// It is nil, if no info has been specified.
+var infoBytes []byte
+
+// entropyBytes contains the bytes of the entropy input of a DRBG.
+// It is nil, if no entropy input has been specified.
+var entropyBytes []byte
+
+// reseedBytes contains the bytes of the entropy input of a DRBG reseed.
+// It is nil, if no reseed has been specified.
+var reseedBytes []byte
+
+// nonceBytes contains the bytes of the nonce.
+// It is nil, if no nonce has been specified.
+var nonceBytes []byte
+
+// outputLength is the output length in bytes.
+// It is 0, if no output length has been specified.
+var outputLength int
 
 // ******** Private functions ********
 
@@ -112,13 +358,53 @@ var sourceBytes []byte
 func parseCommandLineWithFlags() {
 	// 1. Define flags.
 	flag.StringVar(&hashAlgorithm, `hash`, ``, "name of hash `algorithm`")
-	flag.StringVar(&source, `source`, ``, "Source `text` (mutually exclusive with 'hexsource' and 'file')")
-	flag.StringVar(&hexSource, `hexsource`, ``, "Hexadecimal source `text` (mutually exclusive with 'source' and 'file')")
-	flag.StringVar(&fileName, `file`, ``, "Source file `path` (mutually exclusive with 'source' and 'hexsource')")
-	flag.StringVar(&encodingType, `encoding`, `hex`, "Encoding `type` of hash value (one of 'hex', 'base16', 'base32', 'base64', or 'z85')")
+	flag.StringVar(&crcParameters, `crc-params`, ``, "Custom CRC `parameters`, e.g. 'width=16 poly=0x1021 init=0xffff' (mutually exclusive with all other algorithm options)")
+	flag.StringVar(&passwordHashAlgorithm, `password-hash`, ``, "Name of password hash `algorithm` (mutually exclusive with all other algorithm options)")
+	flag.StringVar(&verifyPassword, `verify-password`, ``, "Encoded password `hash` that the source is verified against (mutually exclusive with all other algorithm options)")
+	flag.StringVar(&hkdfAlgorithm, `hkdf`, ``, "Name of hash `algorithm` for HKDF key derivation (mutually exclusive with all other algorithm options)")
+	flag.StringVar(&ikm, `ikm`, ``, "Input keying material `text` for HKDF (mutually exclusive with 'hexikm')")
+	flag.StringVar(&hexIKM, `hexikm`, ``, "Hexadecimal input keying material `text` for HKDF (mutually exclusive with 'ikm')")
+	flag.StringVar(&info, `info`, ``, "Info `text` for HKDF (mutually exclusive with 'hexinfo')")
+	flag.StringVar(&hexInfo, `hexinfo`, ``, "Hexadecimal info `text` for HKDF (mutually exclusive with 'info')")
+	flag.BoolVar(&extractOnly, `extract-only`, false, `Only perform the extract step of HKDF (mutually exclusive with 'expand-only')`)
+	flag.StringVar(&hashDRBGAlgorithm, `hash-drbg`, ``, "Name of SHA-2 hash `algorithm` of Hash_DRBG (mutually exclusive with all other algorithm options)")
+	flag.StringVar(&hmacDRBGAlgorithm, `hmac-drbg`, ``, "Name of SHA-2 hash `algorithm` of HMAC_DRBG (mutually exclusive with all other algorithm options)")
+	flag.StringVar(&entropy, `entropy`, ``, "Entropy input `text` (seed) for DRBGs (mutually exclusive with 'hexentropy')")
+	flag.StringVar(&hexEntropy, `hexentropy`, ``, "Hexadecimal entropy input `text` (seed) for DRBGs (mutually exclusive with 'entropy')")
+	flag.StringVar(&reseed, `reseed`, ``, "Entropy input `text` for a reseed of DRBGs (mutually exclusive with 'hexreseed')")
+	flag.StringVar(&hexReseed, `hexreseed`, ``, "Hexadecimal entropy input `text` for a reseed of DRBGs (mutually exclusive with 'reseed')")
+	flag.Int64Var(&count, `count`, 0, "`number` of bytes that DRBGs generate")
+	flag.BoolVar(&expandOnly, `expand-only`, false, `Only perform the expand step of HKDF with the input keying material as pseudorandom key (mutually exclusive with 'extract-only')`)
+	flag.Func(`source`, "Source `text` (mutually exclusive with 'hexsource' and 'file', except for tuple hashes)", sourcePartAdder(sourceTypeText))
+	flag.Func(`hexsource`, "Hexadecimal source `text` (mutually exclusive with 'source' and 'file', except for tuple hashes)", sourcePartAdder(sourceTypeHex))
+	flag.Func(`file`, "Source file `path` (mutually exclusive with 'source' and 'hexsource', except for tuple hashes)", sourcePartAdder(sourceTypeFile))
+	flag.StringVar(&key, `key`, ``, "Key `text` for keyed hash (mutually exclusive with 'hexkey' and 'keyfile')")
+	flag.StringVar(&hexKey, `hexkey`, ``, "Hexadecimal key `text` for keyed hash (mutually exclusive with 'key' and 'keyfile')")
+	flag.StringVar(&keyFileName, `keyfile`, ``, "Key file `path` for keyed hash (mutually exclusive with 'key' and 'hexkey')")
+	flag.StringVar(&lengthText, `length`, ``, "Output `length` in bits, or in bytes with the suffix 'bytes' (only for algorithms with variable output length)")
+	flag.StringVar(&customization, `customization`, ``, "Customization `text` (only for cSHAKE, KMAC, KangarooTwelve and Ascon-CXOF128 functions)")
+	flag.StringVar(&functionName, `function-name`, ``, "Function name `text` (only for cSHAKE functions)")
+	flag.StringVar(&context, `context`, ``, "Context `text` for key derivation (only for BLAKE3)")
+	flag.IntVar(&blockSize, `block-size`, 0, "Block `size` in bytes (only for ParallelHash functions)")
+	flag.Uint64Var(&seed, `seed`, 0, "Seed `number`, decimal or hexadecimal with prefix '0x' (only for xxHash and MurmurHash3 functions)")
+	flag.StringVar(&salt, `salt`, ``, "Salt `text` (only for BLAKE2 functions, password hashes and HKDF, mutually exclusive with 'hexsalt')")
+	flag.StringVar(&hexSalt, `hexsalt`, ``, "Hexadecimal salt `text` (only for BLAKE2 functions, password hashes and HKDF, mutually exclusive with 'salt')")
+	flag.StringVar(&personalization, `personalization`, ``, "Personalization `text` (only for BLAKE2 functions and DRBGs, mutually exclusive with 'hexpersonalization')")
+	flag.StringVar(&hexPersonalization, `hexpersonalization`, ``, "Hexadecimal personalization `text` (only for BLAKE2 functions and DRBGs, mutually exclusive with 'personalization')")
+	flag.StringVar(&nonce, `nonce`, ``, "Nonce `text` (only for AES-GMAC and DRBGs, mutually exclusive with 'hexnonce')")
+	flag.StringVar(&hexNonce, `hexnonce`, ``, "Hexadecimal nonce `text` (only for AES-GMAC and DRBGs, mutually exclusive with 'nonce')")
+	flag.IntVar(&iterations, `iterations`, 0, "`number` of iterations or rounds (only for Argon2, PBKDF2 and SHA-crypt password hashes)")
+	flag.IntVar(&memory, `memory`, 0, "Memory `size` in KiB (only for Argon2 password hashes)")
+	flag.IntVar(&parallelism, `parallelism`, 0, "Degree of `parallelism` (only for Argon2 and scrypt password hashes)")
+	flag.IntVar(&cost, `cost`, 0, "Binary logarithm of the `cost` (only for bcrypt and scrypt password hashes)")
+	flag.IntVar(&blockFactor, `block-factor`, 0, "Block size `factor` r (only for scrypt password hashes)")
+	flag.BoolVar(&usePHC, `phc`, false, `Print password hash as PHC or modular crypt string (mutually exclusive with 'encoding')`)
+	flag.StringVar(&encodingType, `encoding`, `hex`, "Encoding `type` of hash value (one of 'hex', 'base16', 'base32', 'base64', 'z85', 'decimal', or 'raw')")
 	flag.StringVar(&separator, `separator`, ``, "Separator `text` between hex bytes")
 	flag.StringVar(&prefix, `prefix`, ``, "Prefix `text` in front of hex bytes")
 	flag.BoolVar(&showVersion, `version`, false, `Show program version and exit`)
+	flag.BoolVar(&listAlgorithms, `list`, false, `List all algorithms with their properties and exit`)
+	flag.StringVar(&listFormat, `list-format`, listFormatText, "Format `type` of the algorithm list (one of 'text' or 'json')")
 	flag.BoolVar(&useLower, `lower`, false, `Use lower case for hex output`)
 	flag.BoolVar(&useUpper, `upper`, false, `Use upper case for hex output (default)`)
 
@@ -135,7 +421,12 @@ func myUsage() {
 	_, _ = fmt.Fprintf(errWriter, "\nUse '%s' with the following options:\n\n", myName)
 	flag.PrintDefaults()
 	_, _ = fmt.Fprintln(errWriter, "\nSpecify only one encoding.")
-	_, _ = fmt.Fprintf(errWriter, "\nValid hash algorithm names: %s\n", hashfactory.KnownHashNames())
+	knownNames := hashfactory.KnownHashNames()
+	_, _ = fmt.Fprintf(errWriter, "\nValid hash algorithm names: %s\n", slices.DeleteFunc(slices.Clone(knownNames), isChecksum))
+	_, _ = fmt.Fprintln(errWriter, "\nBLAKE2 functions accept any digest size in bits that is a multiple of 8, e.g. 'blake2b-160' or 'blake2s-224'.")
+	_, _ = fmt.Fprintln(errWriter, "\nCommon spellings, OpenSSL and Java names and object identifiers of the algorithms are accepted, too, e.g. 'SHA-256' or '2.16.840.1.101.3.4.2.1'.")
+	_, _ = fmt.Fprintf(errWriter, "\nValid non-cryptographic checksum names: %s\n", slices.DeleteFunc(knownNames, hashfactory.IsCryptographic))
+	_, _ = fmt.Fprintf(errWriter, "\nValid password hash algorithm names: %s\n", password.KnownNames())
 }
 
 // normalizeCommandLineFlags normalizes the command line flags.
@@ -149,9 +440,32 @@ func normalizeCommandLineFlags() {
 		encodingType = `hex`
 	}
 
-	// Normalize hex source.
-	if len(hexSource) > 0 {
-		hexSource = stringhelper.RemoveAllWhitespace(hexSource)
+	// Normalize hex sources.
+	normalizeSourceParts()
+
+	// Normalize hex key.
+	if len(hexKey) > 0 {
+		hexKey = stringhelper.RemoveAllWhitespace(hexKey)
+	}
+
+	// Normalize hex salt.
+	if len(hexSalt) > 0 {
+		hexSalt = stringhelper.RemoveAllWhitespace(hexSalt)
+	}
+
+	// Normalize hex personalization.
+	if len(hexPersonalization) > 0 {
+		hexPersonalization = stringhelper.RemoveAllWhitespace(hexPersonalization)
+	}
+
+	// Normalize hex nonce.
+	if len(hexNonce) > 0 {
+		hexNonce = stringhelper.RemoveAllWhitespace(hexNonce)
+	}
+
+	// Normalize output length.
+	if len(lengthText) > 0 {
+		lengthText = strings.ToLower(stringhelper.RemoveAllWhitespace(lengthText))
 	}
 
 	// Normalize hash algorithm name.
@@ -159,9 +473,60 @@ func normalizeCommandLineFlags() {
 		hashAlgorithm = strings.ToLower(strings.TrimSpace(hashAlgorithm))
 	}
 
-	// File name is *not* normalized as a file name may end or start with blanks.
+	// Normalize password hash algorithm name.
+	if len(passwordHashAlgorithm) > 0 {
+		passwordHashAlgorithm = strings.ToLower(strings.TrimSpace(passwordHashAlgorithm))
+	}
+
+	// Normalize HKDF hash algorithm name.
+	if len(hkdfAlgorithm) > 0 {
+		hkdfAlgorithm = strings.ToLower(strings.TrimSpace(hkdfAlgorithm))
+	}
+
+	// Normalize DRBG hash algorithm names.
+	if len(hashDRBGAlgorithm) > 0 {
+		hashDRBGAlgorithm = strings.ToLower(strings.TrimSpace(hashDRBGAlgorithm))
+	}
+
+	if len(hmacDRBGAlgorithm) > 0 {
+		hmacDRBGAlgorithm = strings.ToLower(strings.TrimSpace(hmacDRBGAlgorithm))
+	}
+
+	// Normalize hex entropy input.
+	if len(hexEntropy) > 0 {
+		hexEntropy = stringhelper.RemoveAllWhitespace(hexEntropy)
+	}
+
+	// Normalize hex reseed entropy input.
+	if len(hexReseed) > 0 {
+		hexReseed = stringhelper.RemoveAllWhitespace(hexReseed)
+	}
+
+	// Normalize hex input keying material.
+	if len(hexIKM) > 0 {
+		hexIKM = stringhelper.RemoveAllWhitespace(hexIKM)
+	}
+
+	// Normalize hex info.
+	if len(hexInfo) > 0 {
+		hexInfo = stringhelper.RemoveAllWhitespace(hexInfo)
+	}
+
+	// Normalize encoded password hash.
+	if len(verifyPassword) > 0 {
+		verifyPassword = strings.TrimSpace(verifyPassword)
+	}
 
-	// Separator and prefix are not normalized as they are always processed as they are.
+	// File names are *not* normalized as a file name may end or start with blanks.
+
+	// Key, salt, personalization, nonce, input keying material, info and entropy inputs are not normalized as they are always processed as they are.
+
+	// Normalize CRC parameters.
+	if len(crcParameters) > 0 {
+		crcParameters = strings.TrimSpace(crcParameters)
+	}
+
+	// Separator, prefix, customization, function name and context are not normalized as they are always processed as they are.
 }
 
 // checkCommandLineFlags checks the command line flags.
@@ -170,45 +535,84 @@ func checkCommandLineFlags() (encodedprinting.EncodedPrinter, int) {
 		return nil, printUsageErrorf(`Arguments without flags present: %s`, flag.Args())
 	}
 
-	if len(hashAlgorithm) == 0 {
+	numAlgorithms := countTrues(
+		len(hashAlgorithm) != 0,
+		len(crcParameters) != 0,
+		len(passwordHashAlgorithm) != 0,
+		len(verifyPassword) != 0,
+		len(hkdfAlgorithm) != 0,
+		len(hashDRBGAlgorithm) != 0,
+		len(hmacDRBGAlgorithm) != 0,
+	)
+
+	if numAlgorithms > 1 {
+		return nil, printUsageError(`Specify only one of 'hash', 'crc-params', 'password-hash', 'verify-password', 'hkdf', 'hash-drbg' or 'hmac-drbg'`)
+	}
+
+	if numAlgorithms == 0 {
 		return nil, printUsageError(`No hash algorithm specified`)
 	}
 
 	flag.Visit(visitOptions)
 
-	numSources := countTrues(haveSource, haveHexSource, haveFile)
+	rc := checkVerifyFlags()
+	if rc != rcOK {
+		return nil, rc
+	}
+
+	rc = checkHKDFFlags()
+	if rc != rcOK {
+		return nil, rc
+	}
 
-	if numSources == 0 {
-		return nil, printUsageError(`Specify either 'source', 'hexsource' or 'file'`)
+	rc = checkDRBGFlags()
+	if rc != rcOK {
+		return nil, rc
 	}
 
-	if numSources > 1 {
-		return nil, printUsageError(`Specify only one of 'source', 'hexsource' or 'file'`)
+	rc = checkPasswordFlags()
+	if rc != rcOK {
+		return nil, rc
 	}
 
-	if haveSource {
-		if len(source) != 0 {
-			sourceBytes = stringhelper.UnsafeStringBytes(source)
-		} else {
-			return nil, printUsageErrorf(errFmtIsEmpty, `Source`)
+	// HKDF and DRBGs have no source.
+	if len(hkdfAlgorithm) == 0 && !isDRBG() {
+		rc = checkSourceParts()
+		if rc != rcOK {
+			return nil, rc
 		}
 	}
 
-	if haveHexSource {
-		if len(hexSource) != 0 {
-			var err error
-			sourceBytes, err = hex.DecodeString(hexSource)
+	rc = checkKeyFlags()
+	if rc != rcOK {
+		return nil, rc
+	}
 
-			if err != nil {
-				return nil, printUsageErrorf(`Invalid hex string: %v`, err)
-			}
-		} else {
-			return nil, printUsageErrorf(errFmtIsEmpty, `Hex source`)
+	saltBytes, rc = getTextOrHexBytes(`salt`, haveSalt, salt, haveHexSalt, hexSalt)
+	if rc != rcOK {
+		return nil, rc
+	}
+
+	personalizationBytes, rc = getTextOrHexBytes(`personalization`, havePersonalization, personalization, haveHexPersonalization, hexPersonalization)
+	if rc != rcOK {
+		return nil, rc
+	}
+
+	nonceBytes, rc = getTextOrHexBytes(`nonce`, haveNonce, nonce, haveHexNonce, hexNonce)
+	if rc != rcOK {
+		return nil, rc
+	}
+
+	if len(lengthText) != 0 {
+		var err error
+		outputLength, err = parseLength(lengthText)
+		if err != nil {
+			return nil, printUsageErrorf(`Invalid length '%s': %v`, lengthText, err)
 		}
 	}
 
-	if haveFile && len(fileName) == 0 {
-		return nil, printUsageErrorf(errFmtIsEmpty, `File name`)
+	if blockSize < 0 || blockSize > hashfactory.MaxBlockSize {
+		return nil, printUsageErrorf(`Block size must be 0 (default) or between 1 and %d`, hashfactory.MaxBlockSize)
 	}
 
 	encodedPrinter, isValid := encodingTypeToPrinter(encodingType)
@@ -231,20 +635,362 @@ func checkCommandLineFlags() (encodedprinting.EncodedPrinter, int) {
 	return encodedPrinter, rcOK
 }
 
+// checkVerifyFlags checks the flags that depend on whether a password is verified.
+func checkVerifyFlags() int {
+	if len(verifyPassword) == 0 {
+		return rcOK
+	}
+
+	if len(verifyIncompatibleOptionsSet) != 0 {
+		return printUsageErrorf(`Option '%s' can not be used with 'verify-password'`, verifyIncompatibleOptionsSet[0])
+	}
+
+	if len(sourceParts) > 1 {
+		return printUsageError(`Specify only one of 'source', 'hexsource' or 'file' for a password verification`)
+	}
+
+	return rcOK
+}
+
+// checkHKDFFlags checks the flags that depend on whether a key is derived with HKDF and gets the HKDF input bytes.
+func checkHKDFFlags() int {
+	if len(hkdfAlgorithm) == 0 {
+		if len(hkdfOnlyOptionsSet) != 0 {
+			return printUsageErrorf(`Option '%s' can only be used with 'hkdf'`, hkdfOnlyOptionsSet[0])
+		}
+
+		return rcOK
+	}
+
+	if len(hkdfIncompatibleOptionsSet) != 0 {
+		return printUsageErrorf(`Option '%s' can not be used with 'hkdf'`, hkdfIncompatibleOptionsSet[0])
+	}
+
+	if extractOnly && expandOnly {
+		return printUsageError(`Specify either 'extract-only' or 'expand-only'`)
+	}
+
+	if extractOnly && len(lengthText) != 0 {
+		return printUsageError(`A length can not be used with 'extract-only'`)
+	}
+
+	if expandOnly && (haveSalt || haveHexSalt) {
+		return printUsageError(`A salt can not be used with 'expand-only'`)
+	}
+
+	if !haveIKM && !haveHexIKM {
+		return printUsageError(`Specify either 'ikm' or 'hexikm'`)
+	}
+
+	var rc int
+	ikmBytes, rc = getTextOrHexBytes(`ikm`, haveIKM, ikm, haveHexIKM, hexIKM)
+	if rc != rcOK {
+		return rc
+	}
+
+	infoBytes, rc = getTextOrHexBytes(`info`, haveInfo, info, haveHexInfo, hexInfo)
+
+	return rc
+}
+
+// checkDRBGFlags checks the flags that depend on whether a DRBG is used and gets the entropy input bytes.
+func checkDRBGFlags() int {
+	if !isDRBG() {
+		if len(drbgOnlyOptionsSet) != 0 {
+			return printUsageErrorf(`Option '%s' can only be used with 'hash-drbg' or 'hmac-drbg'`, drbgOnlyOptionsSet[0])
+		}
+
+		return rcOK
+	}
+
+	if len(drbgIncompatibleOptionsSet) != 0 {
+		return printUsageErrorf(`Option '%s' can not be used with 'hash-drbg' or 'hmac-drbg'`, drbgIncompatibleOptionsSet[0])
+	}
+
+	if !haveEntropy && !haveHexEntropy {
+		return printUsageError(`Specify either 'entropy' or 'hexentropy'`)
+	}
+
+	if count <= 0 {
+		return printUsageError(`Specify a positive 'count'`)
+	}
+
+	if count > maxOutputLength && encodingType != encodingRaw {
+		return printUsageErrorf(`Count must not be greater than %d bytes, except for 'raw' encoding`, maxOutputLength)
+	}
+
+	var rc int
+	entropyBytes, rc = getTextOrHexBytes(`entropy`, haveEntropy, entropy, haveHexEntropy, hexEntropy)
+	if rc != rcOK {
+		return rc
+	}
+
+	reseedBytes, rc = getTextOrHexBytes(`reseed`, haveReseed, reseed, haveHexReseed, hexReseed)
+
+	return rc
+}
+
+// isDRBG returns true, if a DRBG is used.
+func isDRBG() bool {
+	return len(hashDRBGAlgorithm) != 0 || len(hmacDRBGAlgorithm) != 0
+}
+
+// checkPasswordFlags checks the flags that depend on whether a password hash is calculated.
+func checkPasswordFlags() int {
+	if len(passwordHashAlgorithm) == 0 {
+		if len(passwordOnlyOptionsSet) != 0 {
+			return printUsageErrorf(`Option '%s' can only be used with 'password-hash'`, passwordOnlyOptionsSet[0])
+		}
+
+		return rcOK
+	}
+
+	if !password.IsKnown(passwordHashAlgorithm) {
+		return printUsageErrorf(`Invalid password hash algorithm: '%s'`, passwordHashAlgorithm)
+	}
+
+	if len(hashOnlyOptionsSet) != 0 {
+		return printUsageErrorf(`Option '%s' can not be used with 'password-hash'`, hashOnlyOptionsSet[0])
+	}
+
+	if len(sourceParts) > 1 {
+		return printUsageError(`Specify only one of 'source', 'hexsource' or 'file' for a password hash`)
+	}
+
+	if usePHC && haveEncoding {
+		return printUsageError(`Specify either 'phc' or 'encoding'`)
+	}
+
+	return rcOK
+}
+
+// checkKeyFlags checks the key flags and gets the key bytes.
+func checkKeyFlags() int {
+	numKeys := countTrues(haveKey, haveHexKey, haveKeyFile)
+
+	if numKeys > 1 {
+		return printUsageError(`Specify only one of 'key', 'hexkey' or 'keyfile'`)
+	}
+
+	if haveKey {
+		if len(key) != 0 {
+			keyBytes = stringhelper.UnsafeStringBytes(key)
+		} else {
+			return printUsageErrorf(errFmtIsEmpty, `Key`)
+		}
+	}
+
+	if haveHexKey {
+		if len(hexKey) != 0 {
+			var err error
+			keyBytes, err = hex.DecodeString(hexKey)
+
+			if err != nil {
+				return printUsageErrorf(`Invalid hex key: %v`, err)
+			}
+		} else {
+			return printUsageErrorf(errFmtIsEmpty, `Hex key`)
+		}
+	}
+
+	if haveKeyFile {
+		if len(keyFileName) == 0 {
+			return printUsageErrorf(errFmtIsEmpty, `Key file name`)
+		}
+
+		var err error
+		keyBytes, err = os.ReadFile(keyFileName)
+		if err != nil {
+			return printErrorf(`Error reading key file '%s': %v`, keyFileName, err)
+		}
+
+		if len(keyBytes) == 0 {
+			return printUsageErrorf(`Key file '%s' is empty`, keyFileName)
+		}
+	}
+
+	return rcOK
+}
+
+// getTextOrHexBytes gets the bytes of an option that can be specified as text or as hex text.
+// The hex option has the same name as the text option with the prefix 'hex'.
+func getTextOrHexBytes(name string, haveText bool, text string, haveHex bool, hexText string) ([]byte, int) {
+	if haveText && haveHex {
+		return nil, printUsageErrorf(`Specify either '%s' or 'hex%s'`, name, name)
+	}
+
+	if haveText {
+		if len(text) == 0 {
+			return nil, printUsageErrorf(errFmtIsEmpty, capitalize(name))
+		}
+
+		return stringhelper.UnsafeStringBytes(text), rcOK
+	}
+
+	if haveHex {
+		if len(hexText) == 0 {
+			return nil, printUsageErrorf(errFmtIsEmpty, `Hex `+name)
+		}
+
+		result, err := hex.DecodeString(hexText)
+		if err != nil {
+			return nil, printUsageErrorf(`Invalid hex %s: %v`, name, err)
+		}
+
+		return result, rcOK
+	}
+
+	return nil, rcOK
+}
+
+// capitalize returns the text with an upper-case first character.
+func capitalize(text string) string {
+	return strings.ToUpper(text[:1]) + text[1:]
+}
+
+// parseLength parses a length text.
+// The text is a number followed by an optional unit, which may be 'bits' or 'bytes'.
+// If no unit is specified, the number is the length in bits.
+// The result is the length in bytes.
+func parseLength(text string) (int, error) {
+	numberText, isBytes := strings.CutSuffix(text, `bytes`)
+	if !isBytes {
+		numberText, isBytes = strings.CutSuffix(text, `byte`)
+	}
+
+	if !isBytes {
+		numberText, _ = strings.CutSuffix(numberText, `bits`)
+		numberText, _ = strings.CutSuffix(numberText, `bit`)
+	}
+
+	length, err := strconv.Atoi(numberText)
+	if err != nil {
+		return 0, fmt.Errorf(`not a number: '%s'`, numberText)
+	}
+
+	if !isBytes {
+		if length%8 != 0 {
+			return 0, fmt.Errorf(`bit length %d is not a multiple of 8`, length)
+		}
+
+		length >>= 3
+	}
+
+	if length <= 0 {
+		return 0, fmt.Errorf(`length must be greater than 0`)
+	}
+
+	if length > maxOutputLength {
+		return 0, fmt.Errorf(`length must not be greater than %d bytes`, maxOutputLength)
+	}
+
+	return length, nil
+}
+
 // visitOptions is the visitor function that checks which options have been set.
 func visitOptions(f *flag.Flag) {
 	switch f.Name {
-	case `source`:
-		haveSource = true
+	case `key`:
+		haveKey = true
+
+	case `hexkey`:
+		haveHexKey = true
+
+	case `keyfile`:
+		haveKeyFile = true
+
+	case `salt`:
+		haveSalt = true
+
+	case `hexsalt`:
+		haveHexSalt = true
+
+	case `personalization`:
+		havePersonalization = true
+
+	case `hexpersonalization`:
+		haveHexPersonalization = true
+
+	case `nonce`:
+		haveNonce = true
+
+	case `hexnonce`:
+		haveHexNonce = true
+
+	case `ikm`:
+		haveIKM = true
+
+	case `hexikm`:
+		haveHexIKM = true
+
+	case `info`:
+		haveInfo = true
+
+	case `hexinfo`:
+		haveHexInfo = true
+
+	case `entropy`:
+		haveEntropy = true
+
+	case `hexentropy`:
+		haveHexEntropy = true
+
+	case `reseed`:
+		haveReseed = true
+
+	case `hexreseed`:
+		haveHexReseed = true
+
+	case `encoding`:
+		haveEncoding = true
+	}
+
+	switch f.Name {
+	case `key`, `hexkey`, `keyfile`, `customization`, `function-name`, `block-size`, `context`, `seed`,
+		`personalization`, `hexpersonalization`, `nonce`, `hexnonce`:
+		hashOnlyOptionsSet = append(hashOnlyOptionsSet, f.Name)
+
+	case `iterations`, `memory`, `parallelism`, `cost`, `block-factor`, `phc`:
+		passwordOnlyOptionsSet = append(passwordOnlyOptionsSet, f.Name)
+	}
+
+	switch f.Name {
+	case `verify-password`, `source`, `hexsource`, `file`:
+		// These options are used for password verification.
 
-	case `hexsource`:
-		haveHexSource = true
+	default:
+		verifyIncompatibleOptionsSet = append(verifyIncompatibleOptionsSet, f.Name)
+	}
+
+	switch f.Name {
+	case `ikm`, `hexikm`, `info`, `hexinfo`, `extract-only`, `expand-only`:
+		hkdfOnlyOptionsSet = append(hkdfOnlyOptionsSet, f.Name)
 
-	case `file`:
-		haveFile = true
+	case `hkdf`, `salt`, `hexsalt`, `length`, `encoding`, `prefix`, `separator`, `lower`, `upper`:
+		// These options are used for HKDF.
+
+	default:
+		hkdfIncompatibleOptionsSet = append(hkdfIncompatibleOptionsSet, f.Name)
+	}
+
+	switch f.Name {
+	case `entropy`, `hexentropy`, `reseed`, `hexreseed`, `count`:
+		drbgOnlyOptionsSet = append(drbgOnlyOptionsSet, f.Name)
+
+	case `hash-drbg`, `hmac-drbg`, `nonce`, `hexnonce`, `personalization`, `hexpersonalization`,
+		`encoding`, `prefix`, `separator`, `lower`, `upper`:
+		// These options are used for DRBGs.
+
+	default:
+		drbgIncompatibleOptionsSet = append(drbgIncompatibleOptionsSet, f.Name)
 	}
 }
 
+// isChecksum returns true, if the hash algorithm is a non-cryptographic checksum.
+func isChecksum(hashAlgorithm string) bool {
+	return !hashfactory.IsCryptographic(hashAlgorithm)
+}
+
 // countTrues counts the number of arguments that have a value of "true".
 func countTrues(v ...bool) int {
 	result := 0
@@ -272,6 +1018,12 @@ func encodingTypeToPrinter(encodingType string) (encodedprinting.EncodedPrinter,
 	case `z85`:
 		return encodedprinting.NewZ85Encoder(), true
 
+	case `decimal`:
+		return encodedprinting.NewDecimalEncoder(), true
+
+	case encodingRaw:
+		return encodedprinting.NewRawEncoder(), true
+
 	default:
 		return nil, false
 	}
@@ -0,0 +1,194 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.1.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//    2026-10-16: V1.1.0: Add aliases.
+//
+
+package crc
+
+// ******** Private variables ********
+
+// catalogue contains the well-known CRCs.
+// The names and parameters are the ones from the "Catalogue of parametrised CRC algorithms"
+// by Greg Cook (https://reveng.sourceforge.io/crc-catalogue/).
+var catalogue = []*Model{
+	{Name: `CRC-3/GSM`, Width: 3, Poly: 0x3, Init: 0x0, RefIn: false, RefOut: false, XorOut: 0x7, Check: 0x4},
+	{Name: `CRC-3/ROHC`, Width: 3, Poly: 0x3, Init: 0x7, RefIn: true, RefOut: true, XorOut: 0x0, Check: 0x6},
+	{Name: `CRC-4/G-704`, Width: 4, Poly: 0x3, Init: 0x0, RefIn: true, RefOut: true, XorOut: 0x0, Check: 0x7},
+	{Name: `CRC-4/INTERLAKEN`, Width: 4, Poly: 0x3, Init: 0xf, RefIn: false, RefOut: false, XorOut: 0xf, Check: 0xb},
+	{Name: `CRC-5/EPC-C1G2`, Width: 5, Poly: 0x09, Init: 0x09, RefIn: false, RefOut: false, XorOut: 0x00, Check: 0x00},
+	{Name: `CRC-5/G-704`, Width: 5, Poly: 0x15, Init: 0x00, RefIn: true, RefOut: true, XorOut: 0x00, Check: 0x07},
+	{Name: `CRC-5/USB`, Width: 5, Poly: 0x05, Init: 0x1f, RefIn: true, RefOut: true, XorOut: 0x1f, Check: 0x19},
+	{Name: `CRC-6/G-704`, Width: 6, Poly: 0x03, Init: 0x00, RefIn: true, RefOut: true, XorOut: 0x00, Check: 0x06},
+	{Name: `CRC-7/MMC`, Width: 7, Poly: 0x09, Init: 0x00, RefIn: false, RefOut: false, XorOut: 0x00, Check: 0x75},
+	{Name: `CRC-8/AUTOSAR`, Width: 8, Poly: 0x2f, Init: 0xff, RefIn: false, RefOut: false, XorOut: 0xff, Check: 0xdf},
+	{Name: `CRC-8/BLUETOOTH`, Width: 8, Poly: 0xa7, Init: 0x00, RefIn: true, RefOut: true, XorOut: 0x00, Check: 0x26},
+	{Name: `CRC-8/CDMA2000`, Width: 8, Poly: 0x9b, Init: 0xff, RefIn: false, RefOut: false, XorOut: 0x00, Check: 0xda},
+	{Name: `CRC-8/DARC`, Width: 8, Poly: 0x39, Init: 0x00, RefIn: true, RefOut: true, XorOut: 0x00, Check: 0x15},
+	{Name: `CRC-8/DVB-S2`, Width: 8, Poly: 0xd5, Init: 0x00, RefIn: false, RefOut: false, XorOut: 0x00, Check: 0xbc},
+	{Name: `CRC-8/GSM-A`, Width: 8, Poly: 0x1d, Init: 0x00, RefIn: false, RefOut: false, XorOut: 0x00, Check: 0x37},
+	{Name: `CRC-8/I-432-1`, Width: 8, Poly: 0x07, Init: 0x00, RefIn: false, RefOut: false, XorOut: 0x55, Check: 0xa1},
+	{Name: `CRC-8/I-CODE`, Width: 8, Poly: 0x1d, Init: 0xfd, RefIn: false, RefOut: false, XorOut: 0x00, Check: 0x7e},
+	{Name: `CRC-8/MAXIM-DOW`, Width: 8, Poly: 0x31, Init: 0x00, RefIn: true, RefOut: true, XorOut: 0x00, Check: 0xa1},
+	{Name: `CRC-8/NRSC-5`, Width: 8, Poly: 0x31, Init: 0xff, RefIn: false, RefOut: false, XorOut: 0x00, Check: 0xf7},
+	{Name: `CRC-8/ROHC`, Width: 8, Poly: 0x07, Init: 0xff, RefIn: true, RefOut: true, XorOut: 0x00, Check: 0xd0},
+	{Name: `CRC-8/SAE-J1850`, Width: 8, Poly: 0x1d, Init: 0xff, RefIn: false, RefOut: false, XorOut: 0xff, Check: 0x4b},
+	{Name: `CRC-8/SMBUS`, Width: 8, Poly: 0x07, Init: 0x00, RefIn: false, RefOut: false, XorOut: 0x00, Check: 0xf4},
+	{Name: `CRC-8/WCDMA`, Width: 8, Poly: 0x9b, Init: 0x00, RefIn: true, RefOut: true, XorOut: 0x00, Check: 0x25},
+	{Name: `CRC-10/ATM`, Width: 10, Poly: 0x233, Init: 0x000, RefIn: false, RefOut: false, XorOut: 0x000, Check: 0x199},
+	{Name: `CRC-11/FLEXRAY`, Width: 11, Poly: 0x385, Init: 0x01a, RefIn: false, RefOut: false, XorOut: 0x000, Check: 0x5a3},
+	{Name: `CRC-12/UMTS`, Width: 12, Poly: 0x80f, Init: 0x000, RefIn: false, RefOut: true, XorOut: 0x000, Check: 0xdaf},
+	{Name: `CRC-15/CAN`, Width: 15, Poly: 0x4599, Init: 0x0000, RefIn: false, RefOut: false, XorOut: 0x0000, Check: 0x059e},
+	{Name: `CRC-16/ARC`, Width: 16, Poly: 0x8005, Init: 0x0000, RefIn: true, RefOut: true, XorOut: 0x0000, Check: 0xbb3d},
+	{Name: `CRC-16/CDMA2000`, Width: 16, Poly: 0xc867, Init: 0xffff, RefIn: false, RefOut: false, XorOut: 0x0000, Check: 0x4c06},
+	{Name: `CRC-16/CMS`, Width: 16, Poly: 0x8005, Init: 0xffff, RefIn: false, RefOut: false, XorOut: 0x0000, Check: 0xaee7},
+	{Name: `CRC-16/DDS-110`, Width: 16, Poly: 0x8005, Init: 0x800d, RefIn: false, RefOut: false, XorOut: 0x0000, Check: 0x9ecf},
+	{Name: `CRC-16/DECT-R`, Width: 16, Poly: 0x0589, Init: 0x0000, RefIn: false, RefOut: false, XorOut: 0x0001, Check: 0x007e},
+	{Name: `CRC-16/DNP`, Width: 16, Poly: 0x3d65, Init: 0x0000, RefIn: true, RefOut: true, XorOut: 0xffff, Check: 0xea82},
+	{Name: `CRC-16/EN-13757`, Width: 16, Poly: 0x3d65, Init: 0x0000, RefIn: false, RefOut: false, XorOut: 0xffff, Check: 0xc2b7},
+	{Name: `CRC-16/GENIBUS`, Width: 16, Poly: 0x1021, Init: 0xffff, RefIn: false, RefOut: false, XorOut: 0xffff, Check: 0xd64e},
+	{Name: `CRC-16/GSM`, Width: 16, Poly: 0x1021, Init: 0x0000, RefIn: false, RefOut: false, XorOut: 0xffff, Check: 0xce3c},
+	{Name: `CRC-16/IBM-3740`, Width: 16, Poly: 0x1021, Init: 0xffff, RefIn: false, RefOut: false, XorOut: 0x0000, Check: 0x29b1},
+	{Name: `CRC-16/IBM-SDLC`, Width: 16, Poly: 0x1021, Init: 0xffff, RefIn: true, RefOut: true, XorOut: 0xffff, Check: 0x906e},
+	{Name: `CRC-16/KERMIT`, Width: 16, Poly: 0x1021, Init: 0x0000, RefIn: true, RefOut: true, XorOut: 0x0000, Check: 0x2189},
+	{Name: `CRC-16/MAXIM-DOW`, Width: 16, Poly: 0x8005, Init: 0x0000, RefIn: true, RefOut: true, XorOut: 0xffff, Check: 0x44c2},
+	{Name: `CRC-16/MCRF4XX`, Width: 16, Poly: 0x1021, Init: 0xffff, RefIn: true, RefOut: true, XorOut: 0x0000, Check: 0x6f91},
+	{Name: `CRC-16/MODBUS`, Width: 16, Poly: 0x8005, Init: 0xffff, RefIn: true, RefOut: true, XorOut: 0x0000, Check: 0x4b37},
+	{Name: `CRC-16/SPI-FUJITSU`, Width: 16, Poly: 0x1021, Init: 0x1d0f, RefIn: false, RefOut: false, XorOut: 0x0000, Check: 0xe5cc},
+	{Name: `CRC-16/T10-DIF`, Width: 16, Poly: 0x8bb7, Init: 0x0000, RefIn: false, RefOut: false, XorOut: 0x0000, Check: 0xd0db},
+	{Name: `CRC-16/TELEDISK`, Width: 16, Poly: 0xa097, Init: 0x0000, RefIn: false, RefOut: false, XorOut: 0x0000, Check: 0x0fb3},
+	{Name: `CRC-16/UMTS`, Width: 16, Poly: 0x8005, Init: 0x0000, RefIn: false, RefOut: false, XorOut: 0x0000, Check: 0xfee8},
+	{Name: `CRC-16/USB`, Width: 16, Poly: 0x8005, Init: 0xffff, RefIn: true, RefOut: true, XorOut: 0xffff, Check: 0xb4c8},
+	{Name: `CRC-16/XMODEM`, Width: 16, Poly: 0x1021, Init: 0x0000, RefIn: false, RefOut: false, XorOut: 0x0000, Check: 0x31c3},
+	{Name: `CRC-24/BLE`, Width: 24, Poly: 0x00065b, Init: 0x555555, RefIn: true, RefOut: true, XorOut: 0x000000, Check: 0xc25a56},
+	{Name: `CRC-24/FLEXRAY-A`, Width: 24, Poly: 0x5d6dcb, Init: 0xfedcba, RefIn: false, RefOut: false, XorOut: 0x000000, Check: 0x7979bd},
+	{Name: `CRC-24/OPENPGP`, Width: 24, Poly: 0x864cfb, Init: 0xb704ce, RefIn: false, RefOut: false, XorOut: 0x000000, Check: 0x21cf02},
+	{Name: `CRC-32/AIXM`, Width: 32, Poly: 0x814141ab, Init: 0x00000000, RefIn: false, RefOut: false, XorOut: 0x00000000, Check: 0x3010bf7f},
+	{Name: `CRC-32/AUTOSAR`, Width: 32, Poly: 0xf4acfb13, Init: 0xffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffff, Check: 0x1697d06a},
+	{Name: `CRC-32/BASE91-D`, Width: 32, Poly: 0xa833982b, Init: 0xffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffff, Check: 0x87315576},
+	{Name: `CRC-32/BZIP2`, Width: 32, Poly: 0x04c11db7, Init: 0xffffffff, RefIn: false, RefOut: false, XorOut: 0xffffffff, Check: 0xfc891918},
+	{Name: `CRC-32/CD-ROM-EDC`, Width: 32, Poly: 0x8001801b, Init: 0x00000000, RefIn: true, RefOut: true, XorOut: 0x00000000, Check: 0x6ec2edc4},
+	{Name: `CRC-32/CKSUM`, Width: 32, Poly: 0x04c11db7, Init: 0x00000000, RefIn: false, RefOut: false, XorOut: 0xffffffff, Check: 0x765e7680},
+	{Name: `CRC-32/ISCSI`, Width: 32, Poly: 0x1edc6f41, Init: 0xffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffff, Check: 0xe3069283},
+	{Name: `CRC-32/ISO-HDLC`, Width: 32, Poly: 0x04c11db7, Init: 0xffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffff, Check: 0xcbf43926},
+	{Name: `CRC-32/JAMCRC`, Width: 32, Poly: 0x04c11db7, Init: 0xffffffff, RefIn: true, RefOut: true, XorOut: 0x00000000, Check: 0x340bc6d9},
+	{Name: `CRC-32/MEF`, Width: 32, Poly: 0x741b8cd7, Init: 0xffffffff, RefIn: true, RefOut: true, XorOut: 0x00000000, Check: 0xd2c22f51},
+	{Name: `CRC-32/MPEG-2`, Width: 32, Poly: 0x04c11db7, Init: 0xffffffff, RefIn: false, RefOut: false, XorOut: 0x00000000, Check: 0x0376e6e7},
+	{Name: `CRC-32/XFER`, Width: 32, Poly: 0x000000af, Init: 0x00000000, RefIn: false, RefOut: false, XorOut: 0x00000000, Check: 0xbd0be338},
+	{Name: `CRC-40/GSM`, Width: 40, Poly: 0x0004820009, Init: 0x0000000000, RefIn: false, RefOut: false, XorOut: 0xffffffffff, Check: 0xd4164fc646},
+	{Name: `CRC-64/ECMA-182`, Width: 64, Poly: 0x42f0e1eba9ea3693, Init: 0x0000000000000000, RefIn: false, RefOut: false, XorOut: 0x0000000000000000, Check: 0x6c40df5f0b497347},
+	{Name: `CRC-64/GO-ISO`, Width: 64, Poly: 0x000000000000001b, Init: 0xffffffffffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffffffffffff, Check: 0xb90956c775a41001},
+	{Name: `CRC-64/MS`, Width: 64, Poly: 0x259c84cba6426349, Init: 0xffffffffffffffff, RefIn: true, RefOut: true, XorOut: 0x0000000000000000, Check: 0x75d4b74f024eceea},
+	{Name: `CRC-64/REDIS`, Width: 64, Poly: 0xad93d23594c935a9, Init: 0x0000000000000000, RefIn: true, RefOut: true, XorOut: 0x0000000000000000, Check: 0xe9c6d914c4b8d9ca},
+	{Name: `CRC-64/WE`, Width: 64, Poly: 0x42f0e1eba9ea3693, Init: 0xffffffffffffffff, RefIn: false, RefOut: false, XorOut: 0xffffffffffffffff, Check: 0x62ec59e3f1a4f00a},
+	{Name: `CRC-64/XZ`, Width: 64, Poly: 0x42f0e1eba9ea3693, Init: 0xffffffffffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffffffffffff, Check: 0x995dc9bbdf1939fa},
+}
+
+// aliases maps the alias names of the catalogue to the names of the models.
+var aliases = map[string]string{
+	`ARC`:                      `CRC-16/ARC`,
+	`B-CRC-32`:                 `CRC-32/BZIP2`,
+	`CKSUM`:                    `CRC-32/CKSUM`,
+	`CRC-10`:                   `CRC-10/ATM`,
+	`CRC-10/I-610`:             `CRC-10/ATM`,
+	`CRC-11`:                   `CRC-11/FLEXRAY`,
+	`CRC-12/3GPP`:              `CRC-12/UMTS`,
+	`CRC-15`:                   `CRC-15/CAN`,
+	`CRC-16`:                   `CRC-16/ARC`,
+	`CRC-16/ACORN`:             `CRC-16/XMODEM`,
+	`CRC-16/AUG-CCITT`:         `CRC-16/SPI-FUJITSU`,
+	`CRC-16/AUTOSAR`:           `CRC-16/IBM-3740`,
+	`CRC-16/BLUETOOTH`:         `CRC-16/KERMIT`,
+	`CRC-16/BUYPASS`:           `CRC-16/UMTS`,
+	`CRC-16/CCITT`:             `CRC-16/KERMIT`,
+	`CRC-16/CCITT-FALSE`:       `CRC-16/IBM-3740`,
+	`CRC-16/CCITT-TRUE`:        `CRC-16/KERMIT`,
+	`CRC-16/DARC`:              `CRC-16/GENIBUS`,
+	`CRC-16/EPC`:               `CRC-16/GENIBUS`,
+	`CRC-16/EPC-C1G2`:          `CRC-16/GENIBUS`,
+	`CRC-16/I-CODE`:            `CRC-16/GENIBUS`,
+	`CRC-16/ISO-HDLC`:          `CRC-16/IBM-SDLC`,
+	`CRC-16/ISO-IEC-14443-3-B`: `CRC-16/IBM-SDLC`,
+	`CRC-16/LHA`:               `CRC-16/ARC`,
+	`CRC-16/LTE`:               `CRC-16/XMODEM`,
+	`CRC-16/MAXIM`:             `CRC-16/MAXIM-DOW`,
+	`CRC-16/V-41-LSB`:          `CRC-16/KERMIT`,
+	`CRC-16/V-41-MSB`:          `CRC-16/XMODEM`,
+	`CRC-16/VERIFONE`:          `CRC-16/UMTS`,
+	`CRC-16/X-25`:              `CRC-16/IBM-SDLC`,
+	`CRC-24`:                   `CRC-24/OPENPGP`,
+	`CRC-32`:                   `CRC-32/ISO-HDLC`,
+	`CRC-32/AAL5`:              `CRC-32/BZIP2`,
+	`CRC-32/ADCCP`:             `CRC-32/ISO-HDLC`,
+	`CRC-32/BASE91-C`:          `CRC-32/ISCSI`,
+	`CRC-32/CASTAGNOLI`:        `CRC-32/ISCSI`,
+	`CRC-32/DECT-B`:            `CRC-32/BZIP2`,
+	`CRC-32/INTERLAKEN`:        `CRC-32/ISCSI`,
+	`CRC-32/NVME`:              `CRC-32/ISCSI`,
+	`CRC-32/POSIX`:             `CRC-32/CKSUM`,
+	`CRC-32/V-42`:              `CRC-32/ISO-HDLC`,
+	`CRC-32/XZ`:                `CRC-32/ISO-HDLC`,
+	`CRC-32C`:                  `CRC-32/ISCSI`,
+	`CRC-32D`:                  `CRC-32/BASE91-D`,
+	`CRC-32Q`:                  `CRC-32/AIXM`,
+	`CRC-4/ITU`:                `CRC-4/G-704`,
+	`CRC-5/EPC`:                `CRC-5/EPC-C1G2`,
+	`CRC-5/ITU`:                `CRC-5/G-704`,
+	`CRC-6/ITU`:                `CRC-6/G-704`,
+	`CRC-64`:                   `CRC-64/ECMA-182`,
+	`CRC-64/GO-ECMA`:           `CRC-64/XZ`,
+	`CRC-7`:                    `CRC-7/MMC`,
+	`CRC-8`:                    `CRC-8/SMBUS`,
+	`CRC-8/ITU`:                `CRC-8/I-432-1`,
+	`CRC-8/MAXIM`:              `CRC-8/MAXIM-DOW`,
+	`CRC-B`:                    `CRC-16/IBM-SDLC`,
+	`CRC-CCITT`:                `CRC-16/KERMIT`,
+	`CRC-IBM`:                  `CRC-16/ARC`,
+	`DOW-CRC`:                  `CRC-8/MAXIM-DOW`,
+	`JAMCRC`:                   `CRC-32/JAMCRC`,
+	`KERMIT`:                   `CRC-16/KERMIT`,
+	`MODBUS`:                   `CRC-16/MODBUS`,
+	`PKZIP`:                    `CRC-32/ISO-HDLC`,
+	`R-CRC-16`:                 `CRC-16/DECT-R`,
+	`X-25`:                     `CRC-16/IBM-SDLC`,
+	`XFER`:                     `CRC-32/XFER`,
+	`XMODEM`:                   `CRC-16/XMODEM`,
+	`ZMODEM`:                   `CRC-16/XMODEM`,
+}
+
+// ******** Public functions ********
+
+// Catalogue returns the models of the well-known CRCs.
+// The returned models must not be modified.
+func Catalogue() []*Model {
+	return catalogue
+}
+
+// Aliases returns the map from the alias names of the catalogue to the names of the models.
+// The returned map must not be modified.
+func Aliases() map[string]string {
+	return aliases
+}
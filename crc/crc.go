@@ -0,0 +1,269 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+// Package crc implements cyclic redundancy checks with arbitrary parameters
+// following the Rocksoft model by Ross Williams
+// (https://zlib.net/crc_v3.txt, "A Painless Guide to CRC Error Detection Algorithms").
+package crc
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+// ******** Public types ********
+
+// Model contains the parameters of a cyclic redundancy check in the Rocksoft model.
+type Model struct {
+	// Name is the name of the CRC.
+	Name string
+
+	// Width is the width of the CRC in bits. It is between 1 and 64.
+	Width int
+
+	// Poly is the generator polynomial without the most significant bit.
+	Poly uint64
+
+	// Init is the initial value of the register.
+	Init uint64
+
+	// RefIn is true, if the input bytes are reflected.
+	RefIn bool
+
+	// RefOut is true, if the register is reflected before the final XOR.
+	RefOut bool
+
+	// XorOut is the value that the register is XORed with at the end.
+	XorOut uint64
+
+	// Check is the CRC of the ASCII string "123456789".
+	Check uint64
+}
+
+// Digest calculates a cyclic redundancy check.
+type Digest struct {
+	model    *Model
+	table    [256]uint64
+	register uint64
+	initial  uint64
+}
+
+// ******** Private constants ********
+
+// checkInput is the input that is used for the check value.
+const checkInput = `123456789`
+
+// ******** Private variables ********
+
+// Model errors.
+var (
+	errWidthInvalid = errors.New(`width must be between 1 and 64`)
+)
+
+// ******** Public functions ********
+
+// New creates a new CRC digest with the parameters of the model.
+// An error is returned, if the parameters are not valid.
+func New(model *Model) (*Digest, error) {
+	err := model.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Digest{model: model}
+
+	if model.RefIn {
+		d.makeReflectedTable()
+		d.initial = reflect(model.Init, model.Width)
+	} else {
+		d.makeTable()
+		d.initial = model.Init << (64 - model.Width)
+	}
+
+	d.Reset()
+
+	return d, nil
+}
+
+// CheckValue calculates the CRC of the check input "123456789".
+func (m *Model) CheckValue() (uint64, error) {
+	d, err := New(m)
+	if err != nil {
+		return 0, err
+	}
+
+	_, _ = d.Write([]byte(checkInput))
+
+	return d.Sum64(), nil
+}
+
+// -------- Digest methods --------
+
+// Write adds more data to the running CRC.
+func (d *Digest) Write(p []byte) (int, error) {
+	register := d.register
+
+	if d.model.RefIn {
+		for _, b := range p {
+			register = d.table[byte(register)^b] ^ (register >> 8)
+		}
+	} else {
+		for _, b := range p {
+			register = d.table[byte(register>>56)^b] ^ (register << 8)
+		}
+	}
+
+	d.register = register
+
+	return len(p), nil
+}
+
+// Sum64 returns the current CRC value.
+func (d *Digest) Sum64() uint64 {
+	m := d.model
+
+	var value uint64
+	if m.RefIn {
+		// The register holds the reflected value.
+		value = d.register
+		if !m.RefOut {
+			value = reflect(value, m.Width)
+		}
+	} else {
+		// The register holds the value in its most significant bits.
+		value = d.register >> (64 - m.Width)
+		if m.RefOut {
+			value = reflect(value, m.Width)
+		}
+	}
+
+	return value ^ m.XorOut
+}
+
+// Sum appends the current CRC value in big-endian byte order to b and returns the resulting slice.
+// It does not change the underlying CRC state.
+func (d *Digest) Sum(b []byte) []byte {
+	var buffer [8]byte
+	binary.BigEndian.PutUint64(buffer[:], d.Sum64())
+
+	return append(b, buffer[8-d.Size():]...)
+}
+
+// Reset resets the CRC to its initial state.
+func (d *Digest) Reset() {
+	d.register = d.initial
+}
+
+// Size returns the number of bytes Sum will return.
+func (d *Digest) Size() int {
+	return (d.model.Width + 7) >> 3
+}
+
+// BlockSize returns the CRC's underlying block size.
+func (d *Digest) BlockSize() int {
+	return 1
+}
+
+// ******** Private functions ********
+
+// validate checks whether the parameters of the model are valid.
+func (m *Model) validate() error {
+	if m.Width < 1 || m.Width > 64 {
+		return errWidthInvalid
+	}
+
+	mask := widthMask(m.Width)
+
+	if m.Poly&mask != m.Poly {
+		return fmt.Errorf(`poly 0x%x is wider than %d bits`, m.Poly, m.Width)
+	}
+
+	if m.Poly&1 == 0 {
+		return fmt.Errorf(`poly 0x%x is not odd`, m.Poly)
+	}
+
+	if m.Init&mask != m.Init {
+		return fmt.Errorf(`init 0x%x is wider than %d bits`, m.Init, m.Width)
+	}
+
+	if m.XorOut&mask != m.XorOut {
+		return fmt.Errorf(`xorout 0x%x is wider than %d bits`, m.XorOut, m.Width)
+	}
+
+	return nil
+}
+
+// makeTable creates the table for the non-reflected algorithm.
+// The register is aligned to the most significant bit, so that all widths can be processed in the same way.
+func (d *Digest) makeTable() {
+	poly := d.model.Poly << (64 - d.model.Width)
+
+	for i := range d.table {
+		value := uint64(i) << 56
+		for range 8 {
+			if value&(1<<63) != 0 {
+				value = (value << 1) ^ poly
+			} else {
+				value <<= 1
+			}
+		}
+
+		d.table[i] = value
+	}
+}
+
+// makeReflectedTable creates the table for the reflected algorithm.
+// The register is aligned to the least significant bit.
+func (d *Digest) makeReflectedTable() {
+	poly := reflect(d.model.Poly, d.model.Width)
+
+	for i := range d.table {
+		value := uint64(i)
+		for range 8 {
+			if value&1 != 0 {
+				value = (value >> 1) ^ poly
+			} else {
+				value >>= 1
+			}
+		}
+
+		d.table[i] = value
+	}
+}
+
+// reflect reverses the order of the lowest width bits of value.
+func reflect(value uint64, width int) uint64 {
+	return bits.Reverse64(value) >> (64 - width)
+}
+
+// widthMask returns a mask with the lowest width bits set.
+func widthMask(width int) uint64 {
+	return ^uint64(0) >> (64 - width)
+}
@@ -0,0 +1,161 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package crc
+
+import (
+	"testing"
+)
+
+// ******** Private types ********
+
+// invalidSpecification is a CRC specification that must be rejected.
+type invalidSpecification struct {
+	spec          string
+	expectedError string
+}
+
+// ******** Private variables ********
+
+// invalidSpecifications contains specifications with invalid parameters.
+var invalidSpecifications = []invalidSpecification{
+	{spec: `width=0 poly=0x1`, expectedError: `width must be between 1 and 64`},
+	{spec: `width=65 poly=0x1`, expectedError: `invalid value '65' for parameter 'width': width must be between 1 and 64`},
+	{spec: `width=x poly=0x1`, expectedError: `invalid value 'x' for parameter 'width': invalid syntax`},
+	{spec: `width=16 poly=0x1020`, expectedError: `poly 0x1020 is not odd`},
+	{spec: `width=16 poly=0x11021`, expectedError: `poly 0x11021 is wider than 16 bits`},
+	{spec: `width=16 poly=0xg`, expectedError: `invalid value '0xg' for parameter 'poly': invalid syntax`},
+	{spec: `width=16 poly=0x1021 init=0x10000`, expectedError: `init 0x10000 is wider than 16 bits`},
+	{spec: `width=16 poly=0x1021 init=-1`, expectedError: `invalid value '-1' for parameter 'init': invalid syntax`},
+	{spec: `width=16 poly=0x1021 xorout=0x1ffff`, expectedError: `xorout 0x1ffff is wider than 16 bits`},
+	{spec: `width=64 poly=0x1b xorout=0x1ffffffffffffffff`, expectedError: `invalid value '0x1ffffffffffffffff' for parameter 'xorout': value out of range`},
+	{spec: `width=16 poly=0x1021 refin=maybe`, expectedError: `invalid value 'maybe' for parameter 'refin': invalid syntax`},
+	{spec: `width=16 poly=0x1021 check=0x1234`, expectedError: `check value 0x1234 does not match calculated value 0x31c3`},
+	{spec: `poly=0x1021`, expectedError: `parameter 'width' is missing`},
+	{spec: `width=16`, expectedError: `parameter 'poly' is missing`},
+	{spec: `width=16 poly=0x1021 width=8`, expectedError: `parameter 'width' is specified more than once`},
+	{spec: `width=16 poly=0x1021 size=2`, expectedError: `unknown parameter 'size'`},
+	{spec: `width=16 poly`, expectedError: `parameter 'poly' has no value`},
+}
+
+// ******** Test functions ********
+
+// TestCatalogue tests that the check values of all models of the catalogue are correct.
+func TestCatalogue(t *testing.T) {
+	for _, m := range Catalogue() {
+		check, err := m.CheckValue()
+		if err != nil {
+			t.Errorf(`%s: unexpected error: %v`, m.Name, err)
+			continue
+		}
+
+		if check != m.Check {
+			t.Errorf(`%s: got check value 0x%x, expected 0x%x`, m.Name, check, m.Check)
+		}
+
+		checkBytewise(t, m)
+	}
+}
+
+// TestAliases tests that all aliases refer to models of the catalogue.
+func TestAliases(t *testing.T) {
+	names := make(map[string]bool)
+	for _, m := range Catalogue() {
+		names[m.Name] = true
+	}
+
+	for alias, name := range Aliases() {
+		if !names[name] {
+			t.Errorf(`Alias %s refers to unknown model %s`, alias, name)
+		}
+	}
+}
+
+// TestParseModel tests the parsing of valid specifications.
+func TestParseModel(t *testing.T) {
+	m, err := ParseModel(`width=16 poly=0x1021 init=0xffff refin=false refout=false xorout=0x0000 check=0x29b1 name=CRC-16/IBM-3740`)
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	expected := Model{Name: `CRC-16/IBM-3740`, Width: 16, Poly: 0x1021, Init: 0xffff, XorOut: 0, Check: 0x29b1}
+	if *m != expected {
+		t.Errorf(`Got model %+v, expected %+v`, *m, expected)
+	}
+
+	// CRC-32/ISO-HDLC with decimal numbers, commas, upper case names, a calculated check value and refout defaulting to refin.
+	m, err = ParseModel(`WIDTH=32,POLY=79764919,init=0xFFFFFFFF,refin=true,xorout=0xffffffff`)
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	expected = Model{Name: customName, Width: 32, Poly: 0x04c11db7, Init: 0xffffffff, RefIn: true, RefOut: true, XorOut: 0xffffffff, Check: 0xcbf43926}
+	if *m != expected {
+		t.Errorf(`Got model %+v, expected %+v`, *m, expected)
+	}
+}
+
+// TestParseModelErrors tests that invalid specifications are rejected.
+func TestParseModelErrors(t *testing.T) {
+	for _, s := range invalidSpecifications {
+		_, err := ParseModel(s.spec)
+		if err == nil {
+			t.Errorf(`"%s": expected error, got none`, s.spec)
+			continue
+		}
+
+		if err.Error() != s.expectedError {
+			t.Errorf(`"%s": got error "%v", expected "%s"`, s.spec, err, s.expectedError)
+		}
+	}
+}
+
+// ******** Private functions ********
+
+// checkBytewise checks that the check value is the same, if the check input is written byte by byte,
+// and that the digest returns to its initial state on reset.
+func checkBytewise(t *testing.T, m *Model) {
+	t.Helper()
+
+	d, err := New(m)
+	if err != nil {
+		t.Fatalf(`%s: unexpected error: %v`, m.Name, err)
+	}
+
+	_, _ = d.Write([]byte(`some other data`))
+	d.Reset()
+
+	for _, b := range []byte(checkInput) {
+		_, _ = d.Write([]byte{b})
+	}
+
+	check := d.Sum64()
+	if check != m.Check {
+		t.Errorf(`%s byte by byte: got check value 0x%x, expected 0x%x`, m.Name, check, m.Check)
+	}
+}
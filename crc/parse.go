@@ -0,0 +1,191 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package crc
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ******** Private constants ********
+
+// Parameter names of a CRC specification.
+const (
+	paramWidth  = `width`
+	paramPoly   = `poly`
+	paramInit   = `init`
+	paramRefIn  = `refin`
+	paramRefOut = `refout`
+	paramXorOut = `xorout`
+	paramCheck  = `check`
+	paramName   = `name`
+)
+
+// customName is the name of a CRC specification that has no name.
+const customName = `custom`
+
+// ******** Private variables ********
+
+// Specification errors.
+var (
+	errWidthMissing = errors.New(`parameter 'width' is missing`)
+	errPolyMissing  = errors.New(`parameter 'poly' is missing`)
+)
+
+// ******** Public functions ********
+
+// ParseModel creates a model from a specification of the form
+//
+//	width=16 poly=0x1021 init=0xffff refin=false refout=false xorout=0x0000 check=0x29b1 name=CRC-16/IBM-3740
+//
+// The parameters may be separated by spaces or commas and may appear in any order.
+// Numbers may be given in decimal or, with the prefix "0x", in hexadecimal notation.
+// "width" and "poly" are required. "init" and "xorout" default to 0, "refin" defaults to false
+// and "refout" defaults to the value of "refin".
+// If "check" is present the calculated check value must match it.
+func ParseModel(spec string) (*Model, error) {
+	m := &Model{Name: customName}
+
+	var haveWidth, havePoly, haveRefOut, haveCheck bool
+
+	seen := make(map[string]bool)
+
+	for _, field := range strings.FieldsFunc(spec, isSeparator) {
+		name, value, found := strings.Cut(field, `=`)
+		if !found {
+			return nil, fmt.Errorf(`parameter '%s' has no value`, field)
+		}
+
+		name = strings.ToLower(name)
+		if seen[name] {
+			return nil, fmt.Errorf(`parameter '%s' is specified more than once`, name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case paramWidth:
+			var width uint64
+			width, err = parseNumber(value)
+			if err == nil && width > 64 {
+				err = errWidthInvalid
+			}
+			m.Width = int(width)
+			haveWidth = true
+
+		case paramPoly:
+			m.Poly, err = parseNumber(value)
+			havePoly = true
+
+		case paramInit:
+			m.Init, err = parseNumber(value)
+
+		case paramRefIn:
+			m.RefIn, err = strconv.ParseBool(value)
+
+		case paramRefOut:
+			m.RefOut, err = strconv.ParseBool(value)
+			haveRefOut = true
+
+		case paramXorOut:
+			m.XorOut, err = parseNumber(value)
+
+		case paramCheck:
+			m.Check, err = parseNumber(value)
+			haveCheck = true
+
+		case paramName:
+			m.Name = value
+
+		default:
+			return nil, fmt.Errorf(`unknown parameter '%s'`, name)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf(`invalid value '%s' for parameter '%s': %w`, value, name, unwrapNumError(err))
+		}
+	}
+
+	if !haveWidth {
+		return nil, errWidthMissing
+	}
+
+	if !havePoly {
+		return nil, errPolyMissing
+	}
+
+	if !haveRefOut {
+		m.RefOut = m.RefIn
+	}
+
+	check, err := m.CheckValue()
+	if err != nil {
+		return nil, err
+	}
+
+	if haveCheck {
+		if check != m.Check {
+			return nil, fmt.Errorf(`check value 0x%x does not match calculated value 0x%x`, m.Check, check)
+		}
+	} else {
+		m.Check = check
+	}
+
+	return m, nil
+}
+
+// ******** Private functions ********
+
+// isSeparator checks whether r separates parameters.
+func isSeparator(r rune) bool {
+	return r == ',' || r == ' ' || r == '\t'
+}
+
+// parseNumber parses a decimal or hexadecimal unsigned number.
+func parseNumber(text string) (uint64, error) {
+	lowerText := strings.ToLower(text)
+	if hexText, isHex := strings.CutPrefix(lowerText, `0x`); isHex {
+		return strconv.ParseUint(hexText, 16, 64)
+	}
+
+	return strconv.ParseUint(lowerText, 10, 64)
+}
+
+// unwrapNumError returns the underlying error of a strconv.NumError,
+// as the NumError message repeats the value.
+func unwrapNumError(err error) error {
+	var numErr *strconv.NumError
+	if errors.As(err, &numErr) {
+		return numErr.Err
+	}
+
+	return err
+}
@@ -0,0 +1,179 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+// Package crypt implements the crypt(3) password hash schemes SHA-256-crypt ("$5$") and SHA-512-crypt ("$6$")
+// (https://www.akkadia.org/drepper/SHA-crypt.txt), MD5-crypt ("$1$") and the Apache variant APR1-MD5 ("$apr1$").
+//
+// These schemes are used by /etc/shadow and by htpasswd files. They are legacy schemes and should only be used
+// for compatibility with existing systems.
+package crypt
+
+import (
+	"crypto/rand"
+	"errors"
+	"strings"
+)
+
+// ******** Public types ********
+
+// Result is the result of a crypt(3) password hash.
+type Result struct {
+	// Digest is the final digest of the hash function.
+	Digest []byte
+
+	// Salt is the salt that has been used. It is truncated to the maximum salt length of the scheme.
+	Salt []byte
+
+	// Encoded is the password hash in the crypt(3) format.
+	Encoded string
+}
+
+// ******** Public variables ********
+
+// ErrInvalidSalt is returned when a salt contains a '$' character.
+var ErrInvalidSalt = errors.New(`crypt: salt must not contain '$'`)
+
+// ErrInvalidEncoding is returned when an encoded digest has an invalid length or invalid characters.
+var ErrInvalidEncoding = errors.New(`crypt: invalid encoded digest`)
+
+// ******** Private constants ********
+
+// alphabet is the alphabet of the crypt(3) base64 encoding.
+const alphabet = `./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz`
+
+// ******** Private types ********
+
+// group specifies which digest bytes are encoded into how many characters.
+// An index of -1 denotes a zero byte.
+type group struct {
+	b2, b1, b0 int
+	n          int
+}
+
+// ******** Public functions ********
+
+// NewSalt generates a random salt with the given length that only contains characters of the crypt(3) alphabet.
+func NewSalt(length int) ([]byte, error) {
+	result := make([]byte, length)
+	_, err := rand.Read(result)
+	if err != nil {
+		return nil, err
+	}
+
+	// The alphabet has 64 characters, so the modulo is not biased.
+	for i, b := range result {
+		result[i] = alphabet[b%byte(len(alphabet))]
+	}
+
+	return result, nil
+}
+
+// ******** Private functions ********
+
+// prepareSalt checks the salt and truncates it to the maximum length.
+func prepareSalt(salt []byte, maxLength int) ([]byte, error) {
+	if strings.IndexByte(string(salt), '$') >= 0 {
+		return nil, ErrInvalidSalt
+	}
+
+	return salt[:min(len(salt), maxLength)], nil
+}
+
+// encode encodes the digest with the crypt(3) base64 encoding in the order of the groups.
+func encode(digest []byte, groups []group) string {
+	var sb strings.Builder
+
+	for _, g := range groups {
+		w := uint(byteAt(digest, g.b2))<<16 | uint(byteAt(digest, g.b1))<<8 | uint(byteAt(digest, g.b0))
+		for range g.n {
+			sb.WriteByte(alphabet[w&0x3f])
+			w >>= 6
+		}
+	}
+
+	return sb.String()
+}
+
+// decode decodes a text that has been encoded with encode into a digest with the given size.
+func decode(text string, size int, groups []group) ([]byte, error) {
+	result := make([]byte, size)
+
+	for _, g := range groups {
+		if len(text) < g.n {
+			return nil, ErrInvalidEncoding
+		}
+
+		var w uint
+		for i := g.n - 1; i >= 0; i-- {
+			v := strings.IndexByte(alphabet, text[i])
+			if v < 0 {
+				return nil, ErrInvalidEncoding
+			}
+
+			w = w<<6 | uint(v)
+		}
+
+		text = text[g.n:]
+
+		setByteAt(result, g.b2, byte(w>>16))
+		setByteAt(result, g.b1, byte(w>>8))
+		setByteAt(result, g.b0, byte(w))
+	}
+
+	if len(text) != 0 {
+		return nil, ErrInvalidEncoding
+	}
+
+	return result, nil
+}
+
+// byteAt returns the digest byte at the index or 0, if the index is -1.
+func byteAt(digest []byte, index int) byte {
+	if index < 0 {
+		return 0
+	}
+
+	return digest[index]
+}
+
+// setByteAt sets the digest byte at the index, if the index is not -1.
+func setByteAt(digest []byte, index int, b byte) {
+	if index >= 0 {
+		digest[index] = b
+	}
+}
+
+// repeat returns a slice with the given length that contains the repeated value.
+func repeat(value []byte, length int) []byte {
+	result := make([]byte, 0, length)
+	for len(result) < length {
+		result = append(result, value[:min(len(value), length-len(result))]...)
+	}
+
+	return result
+}
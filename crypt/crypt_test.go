@@ -0,0 +1,204 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package crypt
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// ******** Private types ********
+
+// shaVector is a SHA-crypt test vector.
+type shaVector struct {
+	password string
+	salt     string
+	rounds   int
+	expected string
+}
+
+// md5Vector is a MD5-crypt or APR1-MD5 test vector.
+type md5Vector struct {
+	password string
+	salt     string
+	expected string
+}
+
+// ******** Private variables ********
+
+// The SHA-crypt vectors are the ones from the specification "Unix crypt using SHA-256 and SHA-512"
+// by Ulrich Drepper (https://www.akkadia.org/drepper/SHA-crypt.txt).
+// The last vector of each function has a number of rounds that is clamped to the minimum.
+
+// sha256Vectors contains the SHA-256-crypt test vectors.
+var sha256Vectors = []shaVector{
+	{password: `Hello world!`, salt: `saltstring`, rounds: 0, expected: `$5$saltstring$5B8vYYiY.CVt1RlTTf8KbXBH3hsxY/GNooZaBBGWEc5`},
+	{password: `Hello world!`, salt: `saltstringsaltstring`, rounds: 10000, expected: `$5$rounds=10000$saltstringsaltst$3xv.VbSHBb41AL9AvLeujZkZRBAwqFMz2.opqey6IcA`},
+	{password: `This is just a test`, salt: `toolongsaltstring`, rounds: 5000, expected: `$5$rounds=5000$toolongsaltstrin$Un/5jzAHMgOGZ5.mWJpuVolil07guHPvOW8mGRcvxa5`},
+	{password: `a very much longer text to encrypt.  This one even stretches over morethan one line.`, salt: `anotherlongsaltstring`, rounds: 1400, expected: `$5$rounds=1400$anotherlongsalts$Rx.j8H.h8HjEDGomFU8bDkXm3XIUnzyxf12oP84Bnq1`},
+	{password: `we have a short salt string but not a short password`, salt: `short`, rounds: 77777, expected: `$5$rounds=77777$short$JiO1O3ZpDAxGJeaDIuqCoEFysAe1mZNJRs3pw0KQRd/`},
+	{password: `a short string`, salt: `asaltof16chars..`, rounds: 123456, expected: `$5$rounds=123456$asaltof16chars..$gP3VQ/6X7UUEW3HkBn2w1/Ptq2jxPyzV/cZKmF/wJvD`},
+	{password: `the minimum number is still observed`, salt: `roundstoolow`, rounds: 10, expected: `$5$rounds=1000$roundstoolow$yfvwcWrQ8l/K0DAWyuPMDNHpIVlTQebY9l/gL972bIC`},
+}
+
+// sha512Vectors contains the SHA-512-crypt test vectors.
+var sha512Vectors = []shaVector{
+	{password: `Hello world!`, salt: `saltstring`, rounds: 0, expected: `$6$saltstring$svn8UoSVapNtMuq1ukKS4tPQd8iKwSMHWjl/O817G3uBnIFNjnQJuesI68u4OTLiBFdcbYEdFCoEOfaS35inz1`},
+	{password: `Hello world!`, salt: `saltstringsaltstring`, rounds: 10000, expected: `$6$rounds=10000$saltstringsaltst$OW1/O6BYHV6BcXZu8QVeXbDWra3Oeqh0sbHbbMCVNSnCM/UrjmM0Dp8vOuZeHBy/YTBmSK6H9qs/y3RnOaw5v.`},
+	{password: `This is just a test`, salt: `toolongsaltstring`, rounds: 5000, expected: `$6$rounds=5000$toolongsaltstrin$lQ8jolhgVRVhY4b5pZKaysCLi0QBxGoNeKQzQ3glMhwllF7oGDZxUhx1yxdYcz/e1JSbq3y6JMxxl8audkUEm0`},
+	{password: `a very much longer text to encrypt.  This one even stretches over morethan one line.`, salt: `anotherlongsaltstring`, rounds: 1400, expected: `$6$rounds=1400$anotherlongsalts$POfYwTEok97VWcjxIiSOjiykti.o/pQs.wPvMxQ6Fm7I6IoYN3CmLs66x9t0oSwbtEW7o7UmJEiDwGqd8p4ur1`},
+	{password: `we have a short salt string but not a short password`, salt: `short`, rounds: 77777, expected: `$6$rounds=77777$short$WuQyW2YR.hBNpjjRhpYD/ifIw05xdfeEyQoMxIXbkvr0gge1a1x3yRULJ5CCaUeOxFmtlcGZelFl5CxtgfiAc0`},
+	{password: `a short string`, salt: `asaltof16chars..`, rounds: 123456, expected: `$6$rounds=123456$asaltof16chars..$BtCwjqMJGx5hrJhZywWvt0RLE8uZ4oPwcelCjmw2kSYu.Ec6ycULevoBK25fs2xXgMNrCzIMVcgEJAstJeonj1`},
+	{password: `the minimum number is still observed`, salt: `roundstoolow`, rounds: 10, expected: `$6$rounds=1000$roundstoolow$kUMsbe306n21p9R.FRkW3IGn.S9NPN0x50YhH1xhLsPuWGsUSklZt58jaTfF4ZEQpyUNGc0dqbpBYYBaHHrsX.`},
+}
+
+// The MD5-crypt and APR1-MD5 vectors have been created with "openssl passwd -1" and "openssl passwd -apr1".
+// The salts are truncated to 8 characters.
+
+// md5Vectors contains the MD5-crypt test vectors.
+var md5Vectors = []md5Vector{
+	{password: `Hello world!`, salt: `saltstring`, expected: `$1$saltstri$YMyguxXMBpd2TEZ.vS/3q1`},
+	{password: `password`, salt: `saltstring`, expected: `$1$saltstri$qQY4WxjABChYG1ccLpfkz/`},
+	{password: `0123456789012345678901234567890123456789012345678901234567890123456789abcdefgh`, salt: `s`, expected: `$1$s$sjkpdMgnDGreu0Pr.UJ0d.`},
+}
+
+// apr1Vectors contains the APR1-MD5 test vectors.
+var apr1Vectors = []md5Vector{
+	{password: `Hello world!`, salt: `saltstring`, expected: `$apr1$saltstri$aGfuB7Lcvs2TUeFTqUVfN0`},
+	{password: `password`, salt: `saltstring`, expected: `$apr1$saltstri$KbmdckUzuN1qd7Gpo8DEL.`},
+	{password: ``, salt: `ab/.XY`, expected: `$apr1$ab/.XY$BZ0LRZppoQ8bdYuOJ4mOc.`},
+}
+
+// ******** Test functions ********
+
+// TestSHA256 tests SHA-256-crypt with the test vectors of the specification.
+func TestSHA256(t *testing.T) {
+	for _, v := range sha256Vectors {
+		r, err := SHA256([]byte(v.password), []byte(v.salt), v.rounds)
+		checkResult(t, r, err, v.expected, DecodeSHA256)
+	}
+}
+
+// TestSHA512 tests SHA-512-crypt with the test vectors of the specification.
+func TestSHA512(t *testing.T) {
+	for _, v := range sha512Vectors {
+		r, err := SHA512([]byte(v.password), []byte(v.salt), v.rounds)
+		checkResult(t, r, err, v.expected, DecodeSHA512)
+	}
+}
+
+// TestMD5 tests MD5-crypt.
+func TestMD5(t *testing.T) {
+	for _, v := range md5Vectors {
+		r, err := MD5([]byte(v.password), []byte(v.salt))
+		checkResult(t, r, err, v.expected, DecodeMD5)
+	}
+}
+
+// TestAPR1 tests APR1-MD5.
+func TestAPR1(t *testing.T) {
+	for _, v := range apr1Vectors {
+		r, err := APR1([]byte(v.password), []byte(v.salt))
+		checkResult(t, r, err, v.expected, DecodeMD5)
+	}
+}
+
+// TestInvalidSalt tests that salts with a '$' character are rejected.
+func TestInvalidSalt(t *testing.T) {
+	_, err := SHA256([]byte(`password`), []byte(`salt$salt`), 0)
+	if !errors.Is(err, ErrInvalidSalt) {
+		t.Errorf(`SHA256: got error %v, expected %v`, err, ErrInvalidSalt)
+	}
+
+	_, err = MD5([]byte(`password`), []byte(`$salt`))
+	if !errors.Is(err, ErrInvalidSalt) {
+		t.Errorf(`MD5: got error %v, expected %v`, err, ErrInvalidSalt)
+	}
+}
+
+// TestInvalidEncoding tests that encoded digests with an invalid length or invalid characters are rejected.
+func TestInvalidEncoding(t *testing.T) {
+	for _, text := range []string{
+		``,
+		`YMyguxXMBpd2TEZ.vS/3q`,
+		`YMyguxXMBpd2TEZ.vS/3q1x`,
+		`YMyguxXMBpd2TEZ.vS/3q!`,
+	} {
+		_, err := DecodeMD5(text)
+		if !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf(`"%s": got error %v, expected %v`, text, err, ErrInvalidEncoding)
+		}
+	}
+}
+
+// TestNewSalt tests that generated salts have the requested length and only contain characters of the alphabet.
+func TestNewSalt(t *testing.T) {
+	salt, err := NewSalt(MaxSHASaltLength)
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if len(salt) != MaxSHASaltLength {
+		t.Errorf(`Got salt length %d, expected %d`, len(salt), MaxSHASaltLength)
+	}
+
+	for _, c := range salt {
+		if strings.IndexByte(alphabet, c) < 0 {
+			t.Errorf(`Salt "%s" contains invalid character '%c'`, salt, c)
+		}
+	}
+}
+
+// ******** Private functions ********
+
+// checkResult checks the encoded password hash and that its encoded digest decodes to the digest.
+func checkResult(t *testing.T, r *Result, err error, expected string, decode func(string) ([]byte, error)) {
+	t.Helper()
+
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if r.Encoded != expected {
+		t.Errorf(`Got %s, expected %s`, r.Encoded, expected)
+	}
+
+	encodedDigest := expected[strings.LastIndexByte(expected, '$')+1:]
+
+	digest, err := decode(encodedDigest)
+	if err != nil {
+		t.Fatalf(`%s: unexpected error: %v`, expected, err)
+	}
+
+	if !bytes.Equal(digest, r.Digest) {
+		t.Errorf(`%s: decoded digest %x does not match digest %x`, expected, digest, r.Digest)
+	}
+}
@@ -0,0 +1,136 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package crypt
+
+import (
+	"crypto/md5"
+)
+
+// ******** Public constants ********
+
+// MaxMD5SaltLength is the maximum salt length of MD5-crypt and APR1-MD5. Longer salts are truncated.
+const MaxMD5SaltLength = 8
+
+// ******** Private constants ********
+
+// md5Rounds is the fixed number of rounds of MD5-crypt.
+const md5Rounds = 1_000
+
+// ******** Private variables ********
+
+// md5Groups is the order of the bytes of the MD5-crypt digest in the encoding.
+var md5Groups = []group{
+	{0, 6, 12, 4}, {1, 7, 13, 4}, {2, 8, 14, 4}, {3, 9, 15, 4}, {4, 10, 5, 4},
+	{-1, -1, 11, 2},
+}
+
+// ******** Public functions ********
+
+// MD5 calculates the MD5-crypt password hash "$1$".
+func MD5(password []byte, salt []byte) (*Result, error) {
+	return md5Crypt(`$1$`, password, salt)
+}
+
+// APR1 calculates the APR1-MD5 password hash "$apr1$" of Apache htpasswd files.
+// It only differs from MD5-crypt in the prefix.
+func APR1(password []byte, salt []byte) (*Result, error) {
+	return md5Crypt(`$apr1$`, password, salt)
+}
+
+// DecodeMD5 decodes the encoded digest of a MD5-crypt or APR1-MD5 password hash.
+func DecodeMD5(text string) ([]byte, error) {
+	return decode(text, md5.Size, md5Groups)
+}
+
+// ******** Private functions ********
+
+// md5Crypt calculates a MD5-crypt password hash with the given prefix.
+func md5Crypt(prefix string, password []byte, salt []byte) (*Result, error) {
+	salt, err := prepareSalt(salt, MaxMD5SaltLength)
+	if err != nil {
+		return nil, err
+	}
+
+	h := md5.New()
+
+	// Alternate digest.
+	_, _ = h.Write(password)
+	_, _ = h.Write(salt)
+	_, _ = h.Write(password)
+	alternate := h.Sum(nil)
+
+	// Initial digest. The prefix is part of the hashed data.
+	h.Reset()
+	_, _ = h.Write(password)
+	_, _ = h.Write([]byte(prefix))
+	_, _ = h.Write(salt)
+	_, _ = h.Write(repeat(alternate, len(password)))
+
+	// The original implementation adds the first byte of a cleared buffer, i.e. a zero byte, for each 1 bit.
+	for n := len(password); n > 0; n >>= 1 {
+		if n&1 != 0 {
+			_, _ = h.Write([]byte{0})
+		} else {
+			_, _ = h.Write(password[:1])
+		}
+	}
+	digest := h.Sum(nil)
+
+	for i := range md5Rounds {
+		h.Reset()
+
+		if i&1 != 0 {
+			_, _ = h.Write(password)
+		} else {
+			_, _ = h.Write(digest)
+		}
+
+		if i%3 != 0 {
+			_, _ = h.Write(salt)
+		}
+
+		if i%7 != 0 {
+			_, _ = h.Write(password)
+		}
+
+		if i&1 != 0 {
+			_, _ = h.Write(digest)
+		} else {
+			_, _ = h.Write(password)
+		}
+
+		digest = h.Sum(digest[:0])
+	}
+
+	return &Result{
+		Digest:  digest,
+		Salt:    salt,
+		Encoded: prefix + string(salt) + `$` + encode(digest, md5Groups),
+	}, nil
+}
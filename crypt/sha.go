@@ -0,0 +1,192 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package crypt
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"hash"
+	"strconv"
+)
+
+// ******** Public constants ********
+
+// These are the limits and the default of the number of rounds of SHA-crypt.
+// A number of rounds outside the limits is clamped to the limits.
+const (
+	DefaultRounds = 5_000
+	MinRounds     = 1_000
+	MaxRounds     = 999_999_999
+)
+
+// MaxSHASaltLength is the maximum salt length of SHA-crypt. Longer salts are truncated.
+const MaxSHASaltLength = 16
+
+// ******** Private constants ********
+
+// roundsPrefix is the prefix of the number of rounds in the encoded hash.
+const roundsPrefix = `rounds=`
+
+// ******** Private variables ********
+
+// sha256Groups is the order of the bytes of the SHA-256-crypt digest in the encoding.
+var sha256Groups = []group{
+	{0, 10, 20, 4}, {21, 1, 11, 4}, {12, 22, 2, 4}, {3, 13, 23, 4}, {24, 4, 14, 4},
+	{15, 25, 5, 4}, {6, 16, 26, 4}, {27, 7, 17, 4}, {18, 28, 8, 4}, {9, 19, 29, 4},
+	{-1, 31, 30, 3},
+}
+
+// sha512Groups is the order of the bytes of the SHA-512-crypt digest in the encoding.
+var sha512Groups = []group{
+	{0, 21, 42, 4}, {22, 43, 1, 4}, {44, 2, 23, 4}, {3, 24, 45, 4}, {25, 46, 4, 4},
+	{47, 5, 26, 4}, {6, 27, 48, 4}, {28, 49, 7, 4}, {50, 8, 29, 4}, {9, 30, 51, 4},
+	{31, 52, 10, 4}, {53, 11, 32, 4}, {12, 33, 54, 4}, {34, 55, 13, 4}, {56, 14, 35, 4},
+	{15, 36, 57, 4}, {37, 58, 16, 4}, {59, 17, 38, 4}, {18, 39, 60, 4}, {40, 61, 19, 4},
+	{62, 20, 41, 4},
+	{-1, -1, 63, 2},
+}
+
+// ******** Public functions ********
+
+// SHA256 calculates the SHA-256-crypt password hash "$5$".
+// A number of rounds of 0 selects the default number of rounds, which is not part of the encoded hash.
+func SHA256(password []byte, salt []byte, rounds int) (*Result, error) {
+	return shaCrypt(`$5$`, sha256.New, sha256Groups, password, salt, rounds)
+}
+
+// SHA512 calculates the SHA-512-crypt password hash "$6$".
+// A number of rounds of 0 selects the default number of rounds, which is not part of the encoded hash.
+func SHA512(password []byte, salt []byte, rounds int) (*Result, error) {
+	return shaCrypt(`$6$`, sha512.New, sha512Groups, password, salt, rounds)
+}
+
+// DecodeSHA256 decodes the encoded digest of a SHA-256-crypt password hash.
+func DecodeSHA256(text string) ([]byte, error) {
+	return decode(text, sha256.Size, sha256Groups)
+}
+
+// DecodeSHA512 decodes the encoded digest of a SHA-512-crypt password hash.
+func DecodeSHA512(text string) ([]byte, error) {
+	return decode(text, sha512.Size, sha512Groups)
+}
+
+// ******** Private functions ********
+
+// shaCrypt calculates a SHA-crypt password hash with the given hash function.
+func shaCrypt(
+	prefix string,
+	newHash func() hash.Hash,
+	groups []group,
+	password []byte,
+	salt []byte,
+	rounds int,
+) (*Result, error) {
+	salt, err := prepareSalt(salt, MaxSHASaltLength)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedRounds := ``
+	if rounds == 0 {
+		rounds = DefaultRounds
+	} else {
+		rounds = min(max(rounds, MinRounds), MaxRounds)
+		encodedRounds = roundsPrefix + strconv.Itoa(rounds) + `$`
+	}
+
+	h := newHash()
+	size := h.Size()
+
+	// Digest B.
+	_, _ = h.Write(password)
+	_, _ = h.Write(salt)
+	_, _ = h.Write(password)
+	digestB := h.Sum(nil)
+
+	// Digest A.
+	h.Reset()
+	_, _ = h.Write(password)
+	_, _ = h.Write(salt)
+	_, _ = h.Write(repeat(digestB, len(password)))
+	for n := len(password); n > 0; n >>= 1 {
+		if n&1 != 0 {
+			_, _ = h.Write(digestB)
+		} else {
+			_, _ = h.Write(password)
+		}
+	}
+	digestA := h.Sum(nil)
+
+	// Byte sequence P.
+	h.Reset()
+	for range len(password) {
+		_, _ = h.Write(password)
+	}
+	p := repeat(h.Sum(nil), len(password))
+
+	// Byte sequence S.
+	h.Reset()
+	for range 16 + int(digestA[0]) {
+		_, _ = h.Write(salt)
+	}
+	s := repeat(h.Sum(nil), len(salt))
+
+	digestC := digestA
+	for i := range rounds {
+		h.Reset()
+
+		if i&1 != 0 {
+			_, _ = h.Write(p)
+		} else {
+			_, _ = h.Write(digestC)
+		}
+
+		if i%3 != 0 {
+			_, _ = h.Write(s)
+		}
+
+		if i%7 != 0 {
+			_, _ = h.Write(p)
+		}
+
+		if i&1 != 0 {
+			_, _ = h.Write(digestC)
+		} else {
+			_, _ = h.Write(p)
+		}
+
+		digestC = h.Sum(digestC[:0])
+	}
+
+	return &Result{
+		Digest:  digestC[:size],
+		Salt:    salt,
+		Encoded: prefix + encodedRounds + string(salt) + `$` + encode(digestC, groups),
+	}, nil
+}
@@ -0,0 +1,116 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package main
+
+import (
+	"hashvalue/drbg"
+	"hashvalue/encodedprinting"
+	"hashvalue/hashfactory"
+	"os"
+)
+
+// ******** Private constants ********
+
+// drbgHashFamily is the family of the hash algorithms that can be used for DRBGs.
+const drbgHashFamily = `sha2`
+
+// ******** Private functions ********
+
+// generateDRBGBytes generates pseudorandom bytes with a DRBG and prints them.
+func generateDRBGBytes(encodedPrinter encodedprinting.EncodedPrinter) int {
+	hashAlgorithmName := hashDRBGAlgorithm
+	newDRBG := drbg.NewHash
+	if len(hmacDRBGAlgorithm) != 0 {
+		hashAlgorithmName = hmacDRBGAlgorithm
+		newDRBG = drbg.NewHMAC
+	}
+
+	canonicalName, isKnown := hashfactory.CanonicalName(hashAlgorithmName)
+	if !isKnown {
+		return printUsageErrorf(`Invalid hash algorithm: '%s'%s`, hashAlgorithmName, didYouMean(hashfactory.Suggestions(hashAlgorithmName)))
+	}
+
+	descriptor, _ := hashfactory.Lookup(canonicalName)
+	if descriptor.Family != drbgHashFamily {
+		return printUsageErrorf(`Hash algorithm '%s' can not be used for DRBGs. Only SHA-2 algorithms can be used`, canonicalName)
+	}
+
+	newHash, err := hashfactory.NewHMACHash(canonicalName)
+	if err != nil {
+		return printUsageErrorf(`Hash algorithm '%s' can not be used for DRBGs: %v`, canonicalName, err)
+	}
+
+	d := newDRBG(newHash, entropyBytes, nonceBytes, personalizationBytes)
+
+	if len(reseedBytes) != 0 {
+		err = d.Reseed(reseedBytes, nil)
+		if err != nil {
+			return printErrorf(`Error reseeding DRBG: %v`, err)
+		}
+	}
+
+	// Raw output is streamed, so that it can be arbitrarily long.
+	if encodingType == encodingRaw {
+		return streamDRBGBytes(d)
+	}
+
+	output := make([]byte, count)
+	err = drbg.Read(d, output)
+	if err != nil {
+		return printErrorf(`Error generating bytes: %v`, err)
+	}
+
+	encodedPrinter.PrintEncoded(output)
+
+	return rcOK
+}
+
+// streamDRBGBytes writes the pseudorandom bytes of the DRBG to stdout in blocks of the maximum request size.
+// The bytes are the same as the ones of one call of drbg.Read.
+func streamDRBGBytes(d drbg.DRBG) int {
+	buffer := make([]byte, drbg.MaxRequestSize)
+
+	for remaining := count; remaining > 0; {
+		n := min(remaining, int64(len(buffer)))
+
+		err := d.Generate(buffer[:n], nil)
+		if err != nil {
+			return printErrorf(`Error generating bytes: %v`, err)
+		}
+
+		_, err = os.Stdout.Write(buffer[:n])
+		if err != nil {
+			return printErrorf(`Error writing bytes: %v`, err)
+		}
+
+		remaining -= n
+	}
+
+	return rcOK
+}
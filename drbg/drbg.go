@@ -0,0 +1,105 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+// Package drbg implements the deterministic random bit generators Hash_DRBG and HMAC_DRBG
+// of NIST SP 800-90A Rev. 1 (https://doi.org/10.6028/NIST.SP.800-90Ar1).
+//
+// The generators are deterministic: The same entropy input, nonce, personalization string,
+// reseeds and requests always result in the same output.
+// Prediction resistance is not supported, as the entropy input is always supplied by the caller.
+package drbg
+
+import (
+	"errors"
+)
+
+// ******** Public types ********
+
+// DRBG is a deterministic random bit generator.
+type DRBG interface {
+	// Generate fills the output with pseudorandom bytes. The additional input is optional.
+	// The output must not be longer than MaxRequestSize bytes.
+	Generate(output []byte, additionalInput []byte) error
+
+	// Reseed reseeds the generator with new entropy input. The additional input is optional.
+	Reseed(entropyInput []byte, additionalInput []byte) error
+}
+
+// ******** Public constants ********
+
+// MaxRequestSize is the maximum number of bytes that can be requested by one call of Generate.
+const MaxRequestSize = 1 << 16
+
+// ******** Public variables ********
+
+// ErrRequestTooLarge is returned when more than MaxRequestSize bytes are requested.
+var ErrRequestTooLarge = errors.New(`drbg: request must not be larger than 65536 bytes`)
+
+// ErrReseedRequired is returned when the generator has reached the maximum number of requests since the last reseed.
+var ErrReseedRequired = errors.New(`drbg: reseed required`)
+
+// ******** Private constants ********
+
+// reseedInterval is the maximum number of requests between reseeds.
+const reseedInterval = 1 << 48
+
+// ******** Public functions ********
+
+// Read fills the output with pseudorandom bytes of the generator.
+// Outputs that are longer than MaxRequestSize are generated with successive requests of MaxRequestSize bytes.
+func Read(d DRBG, output []byte) error {
+	for len(output) > 0 {
+		n := min(len(output), MaxRequestSize)
+
+		err := d.Generate(output[:n], nil)
+		if err != nil {
+			return err
+		}
+
+		output = output[n:]
+	}
+
+	return nil
+}
+
+// ******** Private functions ********
+
+// concat concatenates the byte slices in a new byte slice.
+func concat(parts ...[]byte) []byte {
+	length := 0
+	for _, part := range parts {
+		length += len(part)
+	}
+
+	result := make([]byte, 0, length)
+	for _, part := range parts {
+		result = append(result, part...)
+	}
+
+	return result
+}
@@ -0,0 +1,278 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package drbg
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"testing"
+)
+
+// ******** Private types ********
+
+// testVector is a test vector in the format of the NIST CAVP files Hash_DRBG.rsp and HMAC_DRBG.rsp.
+// The generator is instantiated, reseeded, if there is an entropy input for the reseed,
+// and then generates two outputs. The returned bits are the second output.
+type testVector struct {
+	name                  string
+	newHash               func() hash.Hash
+	entropyInput          string
+	nonce                 string
+	personalization       string
+	entropyInputReseed    string
+	additionalInputReseed string
+	additionalInput1      string
+	additionalInput2      string
+	returnedBits          string
+}
+
+// ******** Private variables ********
+
+// The vectors with names that start with "CAVP" are from the NIST CAVP test vectors without prediction resistance.
+// The vectors with names that start with "OpenSSL" have been calculated with the DRBGs of OpenSSL 3.
+// They cover the hash functions with longer seeds and all optional inputs.
+
+// hashDRBGVectors contains the test vectors for Hash_DRBG.
+var hashDRBGVectors = []testVector{
+	{
+		name:                  `CAVP SHA-256 no reseed COUNT 0`,
+		newHash:               sha256.New,
+		entropyInput:          `a65ad0f345db4e0effe875c3a2e71f42c7129d620ff5c119a9ef55f05185e0fb`,
+		nonce:                 `8581f9317517276e06e9607ddbcbcc2e`,
+		personalization:       ``,
+		entropyInputReseed:    ``,
+		additionalInputReseed: ``,
+		additionalInput1:      ``,
+		additionalInput2:      ``,
+		returnedBits:          `d3e160c35b99f340b2628264d1751060e0045da383ff57a57d73a673d2b8d80daaf6a6c35a91bb4579d73fd0c8fed111b0391306828adfed528f018121b3febdc343e797b87dbb63db1333ded9d1ece177cfa6b71fe8ab1da46624ed6415e51ccde2c7ca86e283990eeaeb91120415528b2295910281b02dd431f4c9f70427df`,
+	},
+	{
+		name:                  `OpenSSL SHA-256 reseed with all inputs`,
+		newHash:               sha256.New,
+		entropyInput:          `000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f`,
+		nonce:                 `202122232425262728292a2b2c2d2e2f`,
+		personalization:       `404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f`,
+		entropyInputReseed:    `808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9f`,
+		additionalInputReseed: `a0a1a2a3a4a5a6a7a8a9aaabacadaeafb0b1b2b3b4b5b6b7b8b9babbbcbdbebf`,
+		additionalInput1:      `c0c1c2c3c4c5c6c7c8c9cacbcccdcecfd0d1d2d3d4d5d6d7d8d9dadbdcdddedf`,
+		additionalInput2:      `e0e1e2e3e4e5e6e7e8e9eaebecedeeeff0f1f2f3f4f5f6f7f8f9fafbfcfdfeff`,
+		returnedBits:          `0e485756b339f68fc6cbbada2adb5018a2e1d991905c5691608b07ff209318b255243d684e99ea6d4ec90c85eec56f730ca5bff885ffc994ad32e403828800512a766d9c87d6c63572783e83bf222f2d5a411307d60c9282c1263b2a53b5019eb5daf13771b97d8ffe720ea6ae933eec7bcf7011d41cb71fa39095fbc76a94ca`,
+	},
+	{
+		name:                  `OpenSSL SHA-384 no reseed with additional input`,
+		newHash:               sha512.New384,
+		entropyInput:          `000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f`,
+		nonce:                 `202122232425262728292a2b2c2d2e2f`,
+		personalization:       `404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f`,
+		entropyInputReseed:    ``,
+		additionalInputReseed: ``,
+		additionalInput1:      `c0c1c2c3c4c5c6c7c8c9cacbcccdcecfd0d1d2d3d4d5d6d7d8d9dadbdcdddedf`,
+		additionalInput2:      `e0e1e2e3e4e5e6e7e8e9eaebecedeeeff0f1f2f3f4f5f6f7f8f9fafbfcfdfeff`,
+		returnedBits:          `d27e80602b22d4d080f4456f4c341bcf0b535d4dba591533aab859e17b4cc623e0970ca7ec30ee4b920aae85875770366be12d6b52550cd5079468511dc99948b1d8134de6725a29958e3d88ecf3ae2cc8c1d9486000421d895ccaba1c4f73cb11bde63a4ac9c4b7d24e97fa74df60d33c9aafd2209b7d0fd597ecf252f5a34990a638c7880f60b2d2cb6d2447437fc602db90a2bf4bc973ca010f3ef9261ba849fea3e509d6418990cfa0a09363373558732d3d0ff7399e6788542a0fa69811`,
+	},
+	{
+		name:                  `OpenSSL SHA-512 reseed`,
+		newHash:               sha512.New,
+		entropyInput:          `000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f`,
+		nonce:                 `202122232425262728292a2b2c2d2e2f`,
+		personalization:       ``,
+		entropyInputReseed:    `808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9f`,
+		additionalInputReseed: ``,
+		additionalInput1:      ``,
+		additionalInput2:      ``,
+		returnedBits:          `f60710a77151def9eb2678ed2a6d593a6c081b3fe7557b773a0f59dac85a333cee2305d42dbda3af3f24d5764a0eb621a8fd09ec428e5bfc5922f3a014a70aa9f7e90099c3c15efd8e751b8236b344618125afb33e5cee7186a70a61460d1475ade2dddd7a499af713cfbd0b443cae6a1c6fa1feb767cfd3375e59f861b6f9507f58e192923afed47bd9a1bfa595b7c80958d40fa4d123c9d5b7e0a2aebde9f7299bf91780c5d8821bfa56b7c4eb6630cf015d365014b2f1ef12516362260c619443546959ee05064842cae42f66d4d5cce8080b1ceaa263b383a18b96935e6352aba9c130b2de571fd920bfee42b91e81437ace4a2dec66394307ce835e18ef`,
+	},
+}
+
+// hmacDRBGVectors contains the test vectors for HMAC_DRBG.
+var hmacDRBGVectors = []testVector{
+	{
+		name:                  `CAVP SHA-256 no reseed COUNT 0`,
+		newHash:               sha256.New,
+		entropyInput:          `ca851911349384bffe89de1cbdc46e6831e44d34a4fb935ee285dd14b71a7488`,
+		nonce:                 `659ba96c601dc69fc902940805ec0ca8`,
+		personalization:       ``,
+		entropyInputReseed:    ``,
+		additionalInputReseed: ``,
+		additionalInput1:      ``,
+		additionalInput2:      ``,
+		returnedBits:          `e528e9abf2dece54d47c7e75e5fe302149f817ea9fb4bee6f4199697d04d5b89d54fbb978a15b5c443c9ec21036d2460b6f73ebad0dc2aba6e624abf07745bc107694bb7547bb0995f70de25d6b29e2d3011bb19d27676c07162c8b5ccde0668961df86803482cb37ed6d5c0bb8d50cf1f50d476aa0458bdaba806f48be9dcb8`,
+	},
+	{
+		name:                  `CAVP SHA-256 no reseed COUNT 1`,
+		newHash:               sha256.New,
+		entropyInput:          `79737479ba4e7642a221fcfd1b820b134e9e3540a35bb48ffae29c20f5418ea3`,
+		nonce:                 `3593259c092bef4129bc2c6c9e19f343`,
+		personalization:       ``,
+		entropyInputReseed:    ``,
+		additionalInputReseed: ``,
+		additionalInput1:      ``,
+		additionalInput2:      ``,
+		returnedBits:          `cf5ad5984f9e43917aa9087380dac46e410ddc8a7731859c84e9d0f31bd43655b924159413e2293b17610f211e09f770f172b8fb693a35b85d3b9e5e63b1dc252ac0e115002e9bedfb4b5b6fd43f33b8e0eafb2d072e1a6fee1f159df9b51e6c8da737e60d5032dd30544ec51558c6f080bdbdab1de8a939e961e06b5f1aca37`,
+	},
+	{
+		name:                  `CAVP SHA-256 no reseed with personalization COUNT 0`,
+		newHash:               sha256.New,
+		entropyInput:          `5cacc68165a2e2ee20812f35ec73a79dbf30fd475476ac0c44fc6174cdac2b55`,
+		nonce:                 `6f885496c1e63af620becd9e71ecb824`,
+		personalization:       `e72dd8590d4ed5295515c35ed6199e9d211b8f069b3058caa6670b96ef1208d0`,
+		entropyInputReseed:    ``,
+		additionalInputReseed: ``,
+		additionalInput1:      ``,
+		additionalInput2:      ``,
+		returnedBits:          `f1012cf543f94533df27fedfbf58e5b79a3dc517a9c402bdbfc9a0c0f721f9d53faf4aafdc4b8f7a1b580fcaa52338d4bd95f58966a243cdcd3f446ed4bc546d9f607b190dd69954450d16cd0e2d6437067d8b44d19a6af7a7cfa8794e5fbd728e8fb2f2e8db5dd4ff1aa275f35886098e80ff844886060da8b1e7137846b23b`,
+	},
+	{
+		name:                  `CAVP SHA-256 reseed COUNT 0`,
+		newHash:               sha256.New,
+		entropyInput:          `06032cd5eed33f39265f49ecb142c511da9aff2af71203bffaf34a9ca5bd9c0d`,
+		nonce:                 `0e66f71edc43e42a45ad3c6fc6cdc4df`,
+		personalization:       ``,
+		entropyInputReseed:    `01920a4e669ed3a85ae8a33b35a74ad7fb2a6bb4cf395ce00334a9c9a5a5d552`,
+		additionalInputReseed: ``,
+		additionalInput1:      ``,
+		additionalInput2:      ``,
+		returnedBits:          `76fc79fe9b50beccc991a11b5635783a83536add03c157fb30645e611c2898bb2b1bc215000209208cd506cb28da2a51bdb03826aaf2bd2335d576d519160842e7158ad0949d1a9ec3e66ea1b1a064b005de914eac2e9d4f2d72a8616a80225422918250ff66a41bd2f864a6a38cc5b6499dc43f7f2bd09e1e0f8f5885935124`,
+	},
+	{
+		name:                  `OpenSSL SHA-512 reseed with all inputs`,
+		newHash:               sha512.New,
+		entropyInput:          `000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f`,
+		nonce:                 `202122232425262728292a2b2c2d2e2f`,
+		personalization:       `404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f`,
+		entropyInputReseed:    `808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9f`,
+		additionalInputReseed: `a0a1a2a3a4a5a6a7a8a9aaabacadaeafb0b1b2b3b4b5b6b7b8b9babbbcbdbebf`,
+		additionalInput1:      `c0c1c2c3c4c5c6c7c8c9cacbcccdcecfd0d1d2d3d4d5d6d7d8d9dadbdcdddedf`,
+		additionalInput2:      `e0e1e2e3e4e5e6e7e8e9eaebecedeeeff0f1f2f3f4f5f6f7f8f9fafbfcfdfeff`,
+		returnedBits:          `c53075c41c71310aaa34a5970da8e5c8dad19d29446fb9172d449e4f625088a2daa37d835cce83d06fd48faf9a26ae1f7b732fbae75d4f0b6dd6b98ac8f75760323b8f013c266c7c2525c7b679e9a44be147d08b7384ef208b67199bd9e30b8fb0bdd3c5b40ea1cca5fd54ae50bd5595415c4a8bf8eb0cf9c4de2dbcdf1221b2596fc8d30ad14cab4f2aa0ac0b4b9d38e715c593620d3024d43f6cc8cd510443e9ec5e6b0da85c68438da78dbbe7181dceffbeb3bb1c95401041067d25248f40438b2a12eebe491af276a36289d7fffd6edd861fd1d2cb4333cf7010fee112949a8b4581a510b367cb49d8e46462637220fe72869996be547287e5db8a373740`,
+	},
+}
+
+// ******** Test functions ********
+
+func TestHashDRBG(t *testing.T) {
+	runTestVectors(t, NewHash, hashDRBGVectors)
+}
+
+func TestHMACDRBG(t *testing.T) {
+	runTestVectors(t, NewHMAC, hmacDRBGVectors)
+}
+
+func TestRequestTooLarge(t *testing.T) {
+	for _, newDRBG := range []func(func() hash.Hash, []byte, []byte, []byte) DRBG{NewHash, NewHMAC} {
+		d := newDRBG(sha256.New, make([]byte, 32), make([]byte, 16), nil)
+
+		err := d.Generate(make([]byte, MaxRequestSize+1), nil)
+		if !errors.Is(err, ErrRequestTooLarge) {
+			t.Errorf(`Expected error '%v', got '%v'`, ErrRequestTooLarge, err)
+		}
+	}
+}
+
+func TestRead(t *testing.T) {
+	for _, newDRBG := range []func(func() hash.Hash, []byte, []byte, []byte) DRBG{NewHash, NewHMAC} {
+		entropyInput := make([]byte, 32)
+		nonce := make([]byte, 16)
+
+		// Read must split the output into requests of MaxRequestSize bytes.
+		expected := make([]byte, 2*MaxRequestSize+1)
+		d := newDRBG(sha256.New, entropyInput, nonce, nil)
+		for i := 0; i < len(expected); i += MaxRequestSize {
+			err := d.Generate(expected[i:min(i+MaxRequestSize, len(expected))], nil)
+			if err != nil {
+				t.Fatalf(`Generate failed: %v`, err)
+			}
+		}
+
+		got := make([]byte, len(expected))
+		err := Read(newDRBG(sha256.New, entropyInput, nonce, nil), got)
+		if err != nil {
+			t.Fatalf(`Read failed: %v`, err)
+		}
+
+		if !bytes.Equal(got, expected) {
+			t.Error(`Read does not return the output of successive requests`)
+		}
+	}
+}
+
+// ******** Private functions ********
+
+// runTestVectors checks the test vectors with a DRBG creation function.
+func runTestVectors(
+	t *testing.T,
+	newDRBG func(newHash func() hash.Hash, entropyInput []byte, nonce []byte, personalization []byte) DRBG,
+	vectors []testVector,
+) {
+	t.Helper()
+
+	for _, v := range vectors {
+		d := newDRBG(v.newHash, mustDecode(t, v.entropyInput), mustDecode(t, v.nonce), mustDecode(t, v.personalization))
+
+		if len(v.entropyInputReseed) != 0 {
+			err := d.Reseed(mustDecode(t, v.entropyInputReseed), mustDecode(t, v.additionalInputReseed))
+			if err != nil {
+				t.Fatalf(`%s: reseed failed: %v`, v.name, err)
+			}
+		}
+
+		output := make([]byte, len(v.returnedBits)>>1)
+
+		err := d.Generate(output, mustDecode(t, v.additionalInput1))
+		if err != nil {
+			t.Fatalf(`%s: first generate failed: %v`, v.name, err)
+		}
+
+		err = d.Generate(output, mustDecode(t, v.additionalInput2))
+		if err != nil {
+			t.Fatalf(`%s: second generate failed: %v`, v.name, err)
+		}
+
+		got := hex.EncodeToString(output)
+		if got != v.returnedBits {
+			t.Errorf(`%s: got %s, expected %s`, v.name, got, v.returnedBits)
+		}
+	}
+}
+
+// mustDecode decodes a hexadecimal text and fails the test, if it is not valid.
+func mustDecode(t *testing.T, text string) []byte {
+	t.Helper()
+
+	result, err := hex.DecodeString(text)
+	if err != nil {
+		t.Fatalf(`Invalid hexadecimal text '%s': %v`, text, err)
+	}
+
+	return result
+}
@@ -0,0 +1,160 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package drbg
+
+import (
+	"encoding/binary"
+	"hash"
+)
+
+// ******** Private types ********
+
+// hashDRBG is the Hash_DRBG of SP 800-90A, section 10.1.1.
+type hashDRBG struct {
+	newHash       func() hash.Hash
+	v             []byte
+	c             []byte
+	reseedCounter uint64
+}
+
+// ******** Private constants ********
+
+// These are the seed lengths in bytes of Hash_DRBG from table 2 of SP 800-90A.
+const (
+	hashSmallSeedLength = 440 / 8
+	hashLargeSeedLength = 888 / 8
+)
+
+// ******** Public functions ********
+
+// NewHash creates a Hash_DRBG with the hash function and instantiates it with the entropy input, the nonce
+// and the optional personalization string.
+// The hash function must be one of the SHA-1 or SHA-2 functions.
+func NewHash(newHash func() hash.Hash, entropyInput []byte, nonce []byte, personalization []byte) DRBG {
+	seedLength := hashSmallSeedLength
+	if newHash().Size() > 32 {
+		seedLength = hashLargeSeedLength
+	}
+
+	d := &hashDRBG{newHash: newHash}
+	d.v = d.df(seedLength, entropyInput, nonce, personalization)
+	d.c = d.df(seedLength, []byte{0x00}, d.v)
+	d.reseedCounter = 1
+
+	return d
+}
+
+// ******** Public methods ********
+
+// Generate fills the output with pseudorandom bytes. The additional input is optional.
+func (d *hashDRBG) Generate(output []byte, additionalInput []byte) error {
+	if len(output) > MaxRequestSize {
+		return ErrRequestTooLarge
+	}
+
+	if d.reseedCounter > reseedInterval {
+		return ErrReseedRequired
+	}
+
+	if len(additionalInput) != 0 {
+		addTo(d.v, d.hash([]byte{0x02}, d.v, additionalInput))
+	}
+
+	// Hashgen.
+	data := append([]byte(nil), d.v...)
+	for i := 0; i < len(output); {
+		i += copy(output[i:], d.hash(data))
+		addTo(data, []byte{0x01})
+	}
+
+	h := d.hash([]byte{0x03}, d.v)
+	addTo(d.v, h)
+	addTo(d.v, d.c)
+	addTo(d.v, binary.BigEndian.AppendUint64(nil, d.reseedCounter))
+	d.reseedCounter++
+
+	return nil
+}
+
+// Reseed reseeds the generator with new entropy input. The additional input is optional.
+func (d *hashDRBG) Reseed(entropyInput []byte, additionalInput []byte) error {
+	seedLength := len(d.v)
+
+	d.v = d.df(seedLength, []byte{0x01}, d.v, entropyInput, additionalInput)
+	d.c = d.df(seedLength, []byte{0x00}, d.v)
+	d.reseedCounter = 1
+
+	return nil
+}
+
+// ******** Private methods ********
+
+// hash hashes the concatenation of the parts.
+func (d *hashDRBG) hash(parts ...[]byte) []byte {
+	h := d.newHash()
+	for _, part := range parts {
+		_, _ = h.Write(part)
+	}
+
+	return h.Sum(nil)
+}
+
+// df is the derivation function Hash_df. It derives the given number of bytes from the concatenation of the parts.
+func (d *hashDRBG) df(length int, parts ...[]byte) []byte {
+	input := concat(parts...)
+
+	// The prefix consists of a counter byte and the number of bits to return.
+	prefix := make([]byte, 5)
+	prefix[0] = 1
+	binary.BigEndian.PutUint32(prefix[1:], uint32(length*8))
+
+	result := make([]byte, 0, length+d.newHash().Size())
+	for len(result) < length {
+		result = append(result, d.hash(prefix, input)...)
+		prefix[0]++
+	}
+
+	return result[:length]
+}
+
+// ******** Private functions ********
+
+// addTo adds the big-endian number x to the big-endian number v modulo 2^(8*len(v)).
+func addTo(v []byte, x []byte) {
+	carry := 0
+	for i, j := len(v)-1, len(x)-1; i >= 0; i, j = i-1, j-1 {
+		sum := int(v[i]) + carry
+		if j >= 0 {
+			sum += int(x[j])
+		}
+
+		v[i] = byte(sum)
+		carry = sum >> 8
+	}
+}
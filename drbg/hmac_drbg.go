@@ -0,0 +1,130 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package drbg
+
+import (
+	"crypto/hmac"
+	"hash"
+)
+
+// ******** Private types ********
+
+// hmacDRBG is the HMAC_DRBG of SP 800-90A, section 10.1.2.
+type hmacDRBG struct {
+	newHash       func() hash.Hash
+	k             []byte
+	v             []byte
+	reseedCounter uint64
+}
+
+// ******** Public functions ********
+
+// NewHMAC creates a HMAC_DRBG with the hash function and instantiates it with the entropy input, the nonce
+// and the optional personalization string.
+// The hash function must be one of the SHA-1 or SHA-2 functions.
+func NewHMAC(newHash func() hash.Hash, entropyInput []byte, nonce []byte, personalization []byte) DRBG {
+	size := newHash().Size()
+
+	d := &hmacDRBG{
+		newHash: newHash,
+		k:       make([]byte, size),
+		v:       make([]byte, size),
+	}
+
+	for i := range d.v {
+		d.v[i] = 0x01
+	}
+
+	d.update(entropyInput, nonce, personalization)
+	d.reseedCounter = 1
+
+	return d
+}
+
+// ******** Public methods ********
+
+// Generate fills the output with pseudorandom bytes. The additional input is optional.
+func (d *hmacDRBG) Generate(output []byte, additionalInput []byte) error {
+	if len(output) > MaxRequestSize {
+		return ErrRequestTooLarge
+	}
+
+	if d.reseedCounter > reseedInterval {
+		return ErrReseedRequired
+	}
+
+	if len(additionalInput) != 0 {
+		d.update(additionalInput)
+	}
+
+	for i := 0; i < len(output); {
+		d.v = d.mac(d.v)
+		i += copy(output[i:], d.v)
+	}
+
+	d.update(additionalInput)
+	d.reseedCounter++
+
+	return nil
+}
+
+// Reseed reseeds the generator with new entropy input. The additional input is optional.
+func (d *hmacDRBG) Reseed(entropyInput []byte, additionalInput []byte) error {
+	d.update(entropyInput, additionalInput)
+	d.reseedCounter = 1
+
+	return nil
+}
+
+// ******** Private methods ********
+
+// mac calculates the HMAC with the current key of the concatenation of the parts.
+func (d *hmacDRBG) mac(parts ...[]byte) []byte {
+	h := hmac.New(d.newHash, d.k)
+	for _, part := range parts {
+		_, _ = h.Write(part)
+	}
+
+	return h.Sum(nil)
+}
+
+// update is the update function HMAC_DRBG_Update with the concatenation of the parts as provided data.
+func (d *hmacDRBG) update(parts ...[]byte) {
+	providedData := concat(parts...)
+
+	d.k = d.mac(d.v, []byte{0x00}, providedData)
+	d.v = d.mac(d.v)
+
+	if len(providedData) == 0 {
+		return
+	}
+
+	d.k = d.mac(d.v, []byte{0x01}, providedData)
+	d.v = d.mac(d.v)
+}
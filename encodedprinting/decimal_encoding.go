@@ -0,0 +1,50 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package encodedprinting
+
+import (
+	"math/big"
+	"os"
+)
+
+// DecimalEncoder is used to encode bytes as a decimal integer.
+type DecimalEncoder struct {
+	// There are no fields in this structure.
+}
+
+// NewDecimalEncoder creates a new decimal encoder.
+func NewDecimalEncoder() *DecimalEncoder {
+	return &DecimalEncoder{}
+}
+
+// PrintEncoded prints bytes slices as an unsigned decimal integer.
+// The bytes are interpreted as a big-endian number.
+func (e *DecimalEncoder) PrintEncoded(value []byte) {
+	writeStringln(os.Stdout, new(big.Int).SetBytes(value).String())
+}
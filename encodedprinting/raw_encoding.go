@@ -0,0 +1,48 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package encodedprinting
+
+import (
+	"os"
+)
+
+// RawEncoder is used to print bytes without any encoding.
+type RawEncoder struct {
+	// There are no fields in this structure.
+}
+
+// NewRawEncoder creates a new raw encoder.
+func NewRawEncoder() *RawEncoder {
+	return &RawEncoder{}
+}
+
+// PrintEncoded prints bytes slices as they are, without a newline character.
+func (e *RawEncoder) PrintEncoded(value []byte) {
+	_, _ = os.Stdout.Write(value)
+}
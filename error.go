@@ -20,10 +20,12 @@
 //
 // Author: Frank Schwab
 //
-// Version: 1.0.0
+// Version: 1.2.0
 //
 // Change history:
 //    2024-12-29: V1.0.0: Created.
+//    2026-10-16: V1.1.0: Add warning messages.
+//    2026-10-16: V1.2.0: Add return code for a password mismatch.
 //
 
 package main
@@ -42,6 +44,7 @@ const (
 	rcOK              = 0
 	rcParameterError  = 1
 	rcProcessingError = 2
+	rcMismatch        = 3
 )
 
 // ******** Private functions ********
@@ -82,6 +85,13 @@ func printErrorf(format string, a ...any) int {
 	return rcProcessingError
 }
 
+// printWarningf prints a formatted warning message.
+func printWarningf(format string, a ...any) {
+	_, _ = fmt.Fprint(os.Stderr, `Warning: `)
+	_, _ = fmt.Fprintf(os.Stderr, format, a...)
+	_, _ = fmt.Fprintln(os.Stderr)
+}
+
 // printVersion prints the version information for this program.
 func printVersion() {
 	fmt.Printf("\n%s V%s (%s), %s\n", myName, myVersion, runtime.Version(), myCopyright)
@@ -0,0 +1,370 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.1.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//    2026-10-16: V1.1.0: Add Grøstl-224 and Grøstl-384.
+//
+
+// Package groestl implements the Grøstl hash functions by Praveen Gauravaram, Lars R. Knudsen,
+// Krystian Matusiewicz, Florian Mendel, Christian Rechberger, Martin Schläffer and Søren S. Thomsen
+// in the version that was submitted to the final round of the SHA-3 competition.
+package groestl
+
+import (
+	"encoding/binary"
+	"hash"
+)
+
+// ******** Public constants ********
+
+// Size224 is the size of a Grøstl-224 hash value in bytes.
+const Size224 = 28
+
+// Size256 is the size of a Grøstl-256 hash value in bytes.
+const Size256 = 32
+
+// Size384 is the size of a Grøstl-384 hash value in bytes.
+const Size384 = 48
+
+// Size512 is the size of a Grøstl-512 hash value in bytes.
+const Size512 = 64
+
+// BlockSize256 is the block size of Grøstl-224 and Grøstl-256 in bytes.
+const BlockSize256 = 64
+
+// BlockSize512 is the block size of Grøstl-384 and Grøstl-512 in bytes.
+const BlockSize512 = 128
+
+// ******** Private constants ********
+
+// lengthSize is the size of the block counter in the padding in bytes.
+const lengthSize = 8
+
+// reductionPolynomial is the polynomial x^8 + x^4 + x^3 + x + 1 of the field GF(2^8), as in AES.
+const reductionPolynomial = 0x11b
+
+// ******** Private variables ********
+
+// mixRow is the first row of the circulant matrix of MixBytes.
+var mixRow = [8]byte{2, 2, 3, 4, 5, 3, 5, 7}
+
+// table contains the combined SubBytes and MixBytes transformation of a byte in each row.
+// Each column of the state is a 64 bit value with the first row in the most significant byte.
+var table [8][256]uint64
+
+// ******** Private types ********
+
+// variant contains the parameters of the permutations of a Grøstl variant.
+type variant struct {
+	columns int
+	rounds  int
+	shiftP  [8]int
+	shiftQ  [8]int
+
+	// These are the source columns of each row after ShiftBytes.
+	sourceP [8][16]int
+	sourceQ [8][16]int
+}
+
+// ******** Private variables ********
+
+// These are the parameters of the small and the large variant.
+var (
+	variant256 = &variant{
+		columns: 8,
+		rounds:  10,
+		shiftP:  [8]int{0, 1, 2, 3, 4, 5, 6, 7},
+		shiftQ:  [8]int{1, 3, 5, 7, 0, 2, 4, 6},
+	}
+
+	variant512 = &variant{
+		columns: 16,
+		rounds:  14,
+		shiftP:  [8]int{0, 1, 2, 3, 4, 5, 6, 11},
+		shiftQ:  [8]int{1, 3, 5, 11, 0, 2, 4, 6},
+	}
+)
+
+// ******** Public types ********
+
+// Digest implements the Grøstl hash functions.
+type Digest struct {
+	variant      *variant
+	state        [16]uint64
+	buffer       [BlockSize512]byte
+	bufferedSize int
+	blockCount   uint64
+	size         int
+}
+
+// ******** Public functions ********
+
+// New224 creates a new Grøstl-224 hash function.
+func New224() hash.Hash {
+	return newDigest(variant256, Size224)
+}
+
+// New256 creates a new Grøstl-256 hash function.
+func New256() hash.Hash {
+	return newDigest(variant256, Size256)
+}
+
+// New384 creates a new Grøstl-384 hash function.
+func New384() hash.Hash {
+	return newDigest(variant512, Size384)
+}
+
+// New512 creates a new Grøstl-512 hash function.
+func New512() hash.Hash {
+	return newDigest(variant512, Size512)
+}
+
+// -------- Digest methods --------
+
+// Write adds more data to the running hash.
+func (d *Digest) Write(p []byte) (int, error) {
+	n := len(p)
+	blockSize := d.BlockSize()
+
+	if d.bufferedSize != 0 {
+		copied := copy(d.buffer[d.bufferedSize:blockSize], p)
+		d.bufferedSize += copied
+		p = p[copied:]
+
+		if d.bufferedSize < blockSize {
+			return n, nil
+		}
+
+		d.block(d.buffer[:blockSize])
+		d.bufferedSize = 0
+	}
+
+	for len(p) >= blockSize {
+		d.block(p)
+		p = p[blockSize:]
+	}
+
+	d.bufferedSize = copy(d.buffer[:blockSize], p)
+
+	return n, nil
+}
+
+// Sum appends the current hash value to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (d *Digest) Sum(b []byte) []byte {
+	// Work on a copy, so that the caller can keep writing.
+	c := *d
+	blockSize := c.BlockSize()
+
+	// Padding: A 1 bit, zero bits and the number of blocks as a big-endian 64 bit value.
+	var padding [2 * BlockSize512]byte
+	padding[0] = 0x80
+
+	padLen := blockSize - lengthSize - c.bufferedSize
+	if padLen <= 0 {
+		padLen += blockSize
+	}
+
+	blockCount := c.blockCount + uint64((c.bufferedSize+padLen+lengthSize)/blockSize)
+	binary.BigEndian.PutUint64(padding[padLen:], blockCount)
+	_, _ = c.Write(padding[:padLen+lengthSize])
+
+	// Output transformation: The hash value is the end of P(h) xor h.
+	columns := c.variant.columns
+
+	var x [16]uint64
+	copy(x[:columns], c.state[:columns])
+	c.variant.permuteP(&x)
+
+	var output [BlockSize512]byte
+	for i := 0; i < columns; i++ {
+		binary.BigEndian.PutUint64(output[8*i:], x[i]^c.state[i])
+	}
+
+	return append(b, output[8*columns-c.size:8*columns]...)
+}
+
+// Reset resets the hash to its initial state.
+func (d *Digest) Reset() {
+	// The initial value contains the output size in bits.
+	d.state = [16]uint64{}
+	d.state[d.variant.columns-1] = uint64(d.size) << 3
+	d.bufferedSize = 0
+	d.blockCount = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (d *Digest) Size() int {
+	return d.size
+}
+
+// BlockSize returns the hash's underlying block size.
+func (d *Digest) BlockSize() int {
+	return d.variant.columns << 3
+}
+
+// block processes one block with the compression function f(h, m) = P(h xor m) xor Q(m) xor h.
+func (d *Digest) block(p []byte) {
+	columns := d.variant.columns
+
+	var hm, m [16]uint64
+	for i := 0; i < columns; i++ {
+		m[i] = binary.BigEndian.Uint64(p[8*i:])
+		hm[i] = d.state[i] ^ m[i]
+	}
+
+	d.variant.permuteP(&hm)
+	d.variant.permuteQ(&m)
+
+	for i := 0; i < columns; i++ {
+		d.state[i] ^= hm[i] ^ m[i]
+	}
+
+	d.blockCount++
+}
+
+// ******** Private functions ********
+
+// newDigest creates a new Grøstl hash function with the given variant and hash size in bytes.
+func newDigest(v *variant, size int) *Digest {
+	d := &Digest{variant: v, size: size}
+	d.Reset()
+
+	return d
+}
+
+// init is the package initialization function.
+// It calculates the table from the AES S-box and the MixBytes matrix.
+func init() {
+	sBox := aesSBox()
+
+	for row := range table {
+		for x := range table[row] {
+			s := sBox[x]
+
+			var v uint64
+			for k := 0; k < 8; k++ {
+				v |= uint64(multiply(mixRow[(row-k+8)&7], s)) << (56 - 8*k)
+			}
+
+			table[row][x] = v
+		}
+	}
+
+	for _, v := range []*variant{variant256, variant512} {
+		for row := 0; row < 8; row++ {
+			for j := 0; j < v.columns; j++ {
+				v.sourceP[row][j] = (j + v.shiftP[row]) % v.columns
+				v.sourceQ[row][j] = (j + v.shiftQ[row]) % v.columns
+			}
+		}
+	}
+}
+
+// -------- variant methods --------
+
+// permuteP applies the permutation P to the state.
+func (v *variant) permuteP(x *[16]uint64) {
+	for r := 0; r < v.rounds; r++ {
+		for j := 0; j < v.columns; j++ {
+			x[j] ^= uint64(j<<4^r) << 56
+		}
+
+		v.round(x, &v.sourceP)
+	}
+}
+
+// permuteQ applies the permutation Q to the state.
+func (v *variant) permuteQ(x *[16]uint64) {
+	for r := 0; r < v.rounds; r++ {
+		for j := 0; j < v.columns; j++ {
+			x[j] ^= ^uint64(j<<4 ^ r)
+		}
+
+		v.round(x, &v.sourceQ)
+	}
+}
+
+// round applies ShiftBytes with the given source columns, SubBytes and MixBytes to the state.
+func (v *variant) round(x *[16]uint64, source *[8][16]int) {
+	var result [16]uint64
+
+	for j := 0; j < v.columns; j++ {
+		result[j] = table[0][byte(x[source[0][j]]>>56)] ^
+			table[1][byte(x[source[1][j]]>>48)] ^
+			table[2][byte(x[source[2][j]]>>40)] ^
+			table[3][byte(x[source[3][j]]>>32)] ^
+			table[4][byte(x[source[4][j]]>>24)] ^
+			table[5][byte(x[source[5][j]]>>16)] ^
+			table[6][byte(x[source[6][j]]>>8)] ^
+			table[7][byte(x[source[7][j]])]
+	}
+
+	*x = result
+}
+
+// aesSBox calculates the S-box of AES from the multiplicative inverse and the affine transformation.
+func aesSBox() [256]byte {
+	var sBox [256]byte
+
+	for x := 0; x < 256; x++ {
+		// The inverse of 0 is defined as 0.
+		var inverse byte
+		for y := 1; y < 256 && x != 0; y++ {
+			if multiply(byte(x), byte(y)) == 1 {
+				inverse = byte(y)
+				break
+			}
+		}
+
+		s := inverse
+		for i := 1; i <= 4; i++ {
+			s ^= inverse<<i | inverse>>(8-i)
+		}
+
+		sBox[x] = s ^ 0x63
+	}
+
+	return sBox
+}
+
+// multiply multiplies two elements of the field GF(2^8).
+func multiply(a byte, b byte) byte {
+	var result uint
+	x := uint(a)
+
+	for ; b != 0; b >>= 1 {
+		if b&1 != 0 {
+			result ^= x
+		}
+
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= reductionPolynomial
+		}
+	}
+
+	return byte(result)
+}
@@ -0,0 +1,132 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package groestl
+
+import (
+	"encoding/hex"
+	"hash"
+	"testing"
+)
+
+// ******** Private types ********
+
+// testVector is a Grøstl test vector.
+// The input is the text, if the pattern length is 0.
+// Otherwise, it is the pattern 00 01 02 ... f9 fa 00 01 ... of the given length.
+type testVector struct {
+	newHash       func() hash.Hash
+	name          string
+	text          string
+	patternLength int
+	expected      string
+}
+
+// ******** Private variables ********
+
+// The hash values of the empty input are the ones from the Grøstl submission package.
+// The other values have been calculated with an independent implementation of the specification
+// and cover the padding boundaries and inputs of several blocks.
+
+// testVectors contains the test vectors.
+var testVectors = []testVector{
+	{newHash: New224, name: `Grøstl-224`, text: ``, expected: `f2e180fb5947be964cd584e22e496242c6a329c577fc4ce8c36d34c3`},
+	{newHash: New224, name: `Grøstl-224`, text: `The quick brown fox jumps over the lazy dog`, expected: `8ce3ce0f7092cada755be8f614fd6d5e5738ff1f6cd5dabe42404c46`},
+	{newHash: New224, name: `Grøstl-224`, patternLength: 55, expected: `ba6b932d8c963c10876d02048beb31c79e1721ac3fd199125f9911c7`},
+	{newHash: New224, name: `Grøstl-224`, patternLength: 56, expected: `00afc4f22329ddde5595f7bc24d397d5c3267ae9d4d7795537b93623`},
+	{newHash: New224, name: `Grøstl-224`, patternLength: 119, expected: `68ec2b0c433009571ae2ee19eaa995f377188dee4a111fe48c5af2ab`},
+	{newHash: New224, name: `Grøstl-224`, patternLength: 120, expected: `6a8d08c11d909bc678343916187b176af7245d85febd239b486f4f96`},
+	{newHash: New224, name: `Grøstl-224`, patternLength: 1000, expected: `08715786204a18b3e5cf8843ab9c61ec88ef996cafb18b49cb97b29d`},
+	{newHash: New256, name: `Grøstl-256`, text: ``, expected: `1a52d11d550039be16107f9c58db9ebcc417f16f736adb2502567119f0083467`},
+	{newHash: New256, name: `Grøstl-256`, text: `The quick brown fox jumps over the lazy dog`, expected: `8c7ad62eb26a21297bc39c2d7293b4bd4d3399fa8afab29e970471739e28b301`},
+	{newHash: New256, name: `Grøstl-256`, patternLength: 55, expected: `a2bbd209981d8e092deb8909433a9fc40c63738e1a5ba2d80f30d691205d422e`},
+	{newHash: New256, name: `Grøstl-256`, patternLength: 56, expected: `373a1ecc579afc93bf0fe2140f57dab5aa57bd43a265b5c3c615732cd420dbf5`},
+	{newHash: New256, name: `Grøstl-256`, patternLength: 119, expected: `306265a4d548427c593eced10318acd17eb4abe2ea5e56ae4cbd496b97c164b7`},
+	{newHash: New256, name: `Grøstl-256`, patternLength: 120, expected: `fd4c080302f692160ff3f47c5ee35655867678ef626abe9fbb07e816c967508d`},
+	{newHash: New256, name: `Grøstl-256`, patternLength: 1000, expected: `6cf3d880bad7984385e4f5a313c8558b5c96cf1f1fd970f1f96557529295095d`},
+	{newHash: New384, name: `Grøstl-384`, text: ``, expected: `ac353c1095ace21439251007862d6c62f829ddbe6de4f78e68d310a9205a736d8b11d99bffe448f57a1cfa2934f044a5`},
+	{newHash: New384, name: `Grøstl-384`, text: `The quick brown fox jumps over the lazy dog`, expected: `9330aeb62a1fc0a464dd70ac27b57075e00ae5d627f9bd6ff72952b3857aba2cfbcc4345af9a04fcc13eb346829e4088`},
+	{newHash: New384, name: `Grøstl-384`, patternLength: 55, expected: `015864dc566a52b743d3a4281b977cecad7662b7a9dcaa7e313b9ac2114edea32b54798e5c79b10f4bcdf3e6877b221b`},
+	{newHash: New384, name: `Grøstl-384`, patternLength: 56, expected: `be26bfca5699ed4dc1ebc6ad1ca97adcea3f3fda4c7a28e4839f7ef9df25eaa019a759f21d3e8511771876460da2a7e9`},
+	{newHash: New384, name: `Grøstl-384`, patternLength: 119, expected: `ef2a6088137aaa745ab623f04dc9ed344dcc6855a42ce3b63a9de19d44516e9a01241f8ec94913b88112eb378b96f95c`},
+	{newHash: New384, name: `Grøstl-384`, patternLength: 120, expected: `923c24cc4900dbc51f7040c3cb5a2eb68963d07445069cdd1c7a1224b56bd78f0ed5b700e5dffdc41c8b672c5ae22bd3`},
+	{newHash: New384, name: `Grøstl-384`, patternLength: 1000, expected: `fd490e3e0d9fc4750b38236f259a5c6e05ba8ebccc58078ba165af343d851bbfce0b3764b23392149cfd80aa8b13a0c7`},
+	{newHash: New512, name: `Grøstl-512`, text: ``, expected: `6d3ad29d279110eef3adbd66de2a0345a77baede1557f5d099fce0c03d6dc2ba8e6d4a6633dfbd66053c20faa87d1a11f39a7fbe4a6c2f009801370308fc4ad8`},
+	{newHash: New512, name: `Grøstl-512`, text: `The quick brown fox jumps over the lazy dog`, expected: `badc1f70ccd69e0cf3760c3f93884289da84ec13c70b3d12a53a7a8a4a513f99715d46288f55e1dbf926e6d084a0538e4eebfc91cf2b21452921ccde9131718d`},
+	{newHash: New512, name: `Grøstl-512`, patternLength: 55, expected: `9e5e2be6f64b21f1e2dbd294d8e63011e5041312ce3e6e4c9e7049f356bb1f4326c219c79ed3e0bce11699b8bf6bc42b240afbca9b3e27b371dea5166c8abff9`},
+	{newHash: New512, name: `Grøstl-512`, patternLength: 56, expected: `959a8eacbdf1cae7d91a73cc2bf14a2ec27b6d349d9d2053065a0706a9135cafa507e18424d5bf7c0abb2e29fa22eca17ae4100af32856078ed532988ce18b0b`},
+	{newHash: New512, name: `Grøstl-512`, patternLength: 119, expected: `b37602eb3cb6226e83ce18695d15f19f7e01afff69f4a76103afb789d073a757fc6d97242e80ee92e0953d8617174375ae5227581c1630098e3048bc5bfdfc5a`},
+	{newHash: New512, name: `Grøstl-512`, patternLength: 120, expected: `5cfc13a05459f11cab784846d953da0b7c3eda4855db918da20993665b7e7260cb3711782f402c04b49a03f70414246d56217e97e261cef8f0c225fd124cb971`},
+	{newHash: New512, name: `Grøstl-512`, patternLength: 1000, expected: `3467cf6189535c316e6a4e9081fabe6b308758f7a515ea76205dc62d711d5daf0478628fbcd8fa3e4fe348173f2b5f8c77e4bb34a8a3fa0c1ad24511be15c87d`},
+}
+
+// ******** Test functions ********
+
+// TestGroestl tests the Grøstl hash functions.
+func TestGroestl(t *testing.T) {
+	for _, v := range testVectors {
+		input := []byte(v.text)
+		if v.patternLength != 0 {
+			input = make([]byte, v.patternLength)
+			for i := range input {
+				input[i] = byte(i % 251)
+			}
+		}
+
+		h := v.newHash()
+		if h.Size() != len(v.expected)/2 {
+			t.Errorf(`%s: got size %d, expected %d`, v.name, h.Size(), len(v.expected)/2)
+		}
+
+		_, _ = h.Write(input)
+		checkResult(t, v, len(input), `at once`, h.Sum(nil))
+
+		h.Reset()
+		for i := range input {
+			_, _ = h.Write(input[i : i+1])
+		}
+
+		checkResult(t, v, len(input), `byte by byte`, h.Sum(nil))
+
+		// Sum must not change the state.
+		_, _ = h.Write(nil)
+		checkResult(t, v, len(input), `second sum`, h.Sum(nil))
+	}
+}
+
+// ******** Private functions ********
+
+// checkResult compares a hash value with the expected value.
+func checkResult(t *testing.T, v testVector, inputLength int, mode string, result []byte) {
+	t.Helper()
+
+	resultHex := hex.EncodeToString(result)
+	if resultHex != v.expected {
+		t.Errorf(`%s of %d bytes %s: got %s, expected %s`, v.name, inputLength, mode, resultHex, v.expected)
+	}
+}
@@ -1,5 +1,5 @@
 //
-// SPDX-FileCopyrightText: Copyright 2024-2025 Frank Schwab
+// SPDX-FileCopyrightText: Copyright 2024-2026 Frank Schwab
 //
 // SPDX-License-Identifier: Apache-2.0
 //
@@ -20,54 +20,103 @@
 //
 // Author: Frank Schwab
 //
-// Version: 2.0.0
+// Version: 3.1.0
 //
 // Change history:
 //    2024-12-29: V1.0.0: Created.
 //    2025-03-02: V2.0.0: Calculate from source bytes.
+//    2026-10-16: V3.0.0: Calculate from source parts. Support tuple hashes.
+//    2026-10-16: V3.1.0: Read files with a large buffer.
 //
 
 package main
 
 import (
+	"errors"
 	"fmt"
 	"hash"
 	"hashvalue/filehelper"
+	"hashvalue/hashfactory"
 	"io"
 	"os"
 )
 
+// ******** Private constants ********
+
+// fileBufferSize is the size of the buffer used for reading files.
+// It is large, so that hash functions that hash in parallel get enough data at once.
+const fileBufferSize = 1024 * 1024
+
+// ******** Private variables ********
+
+// errFileSizeChanged is the error that is returned when the size of a file changes while it is read.
+var errFileSizeChanged = errors.New(`file size changed while reading`)
+
 // ******** Private functions ********
 
-// hashData hashes the data in source, hexSource or from file fileName.
-func hashData(hashFunc hash.Hash, sourceBytes []byte, fileName string) ([]byte, error) {
-	var hashValue []byte
-
-	if len(sourceBytes) != 0 {
-		hashFunc.Write(sourceBytes)
-		hashValue = hashFunc.Sum(nil)
-	} else {
-		var err error
-		hashValue, err = fileHash(hashFunc, fileName)
-		if err != nil {
-			return nil, fmt.Errorf(`error reading file '%s': %w`, fileName, err)
+// hashData hashes the data of all source parts.
+// Each source part is an element of the tuple, if the hash function is a tuple hash.
+func hashData(hashFunc hash.Hash, sourceParts []*sourcePart) ([]byte, error) {
+	tupleHash, _ := hashFunc.(hashfactory.TupleHash)
+
+	for _, part := range sourceParts {
+		if part.sourceType == sourceTypeFile {
+			err := fileHash(hashFunc, tupleHash, part.text)
+			if err != nil {
+				return nil, fmt.Errorf(`error reading file '%s': %w`, part.text, err)
+			}
+		} else {
+			if tupleHash != nil {
+				tupleHash.StartElement(uint64(len(part.data)))
+			}
+
+			_, _ = hashFunc.Write(part.data)
 		}
 	}
 
-	return hashValue, nil
+	return hashFunc.Sum(nil), nil
 }
 
-// fileHash calculates the hash value of a file.
-func fileHash(hashFunc hash.Hash, fileName string) ([]byte, error) {
+// fileHash adds the contents of a file to the hash function.
+// If tupleHash is not nil, the contents of the file are a new element of the tuple.
+func fileHash(hashFunc hash.Hash, tupleHash hashfactory.TupleHash, fileName string) error {
 	f, err := os.Open(fileName)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer filehelper.CloseFile(f)
 
-	if _, err = io.Copy(hashFunc, f); err != nil {
-		return nil, err
+	if tupleHash == nil {
+		_, err = copyFile(hashFunc, f)
+		return err
 	}
 
-	return hashFunc.Sum(nil), nil
+	// The length of a tuple element has to be known before the element is hashed.
+	var fi os.FileInfo
+	fi, err = f.Stat()
+	if err != nil {
+		return err
+	}
+
+	size := fi.Size()
+	tupleHash.StartElement(uint64(size))
+
+	var n int64
+	n, err = copyFile(hashFunc, f)
+	if err != nil {
+		return err
+	}
+
+	if n != size {
+		return errFileSizeChanged
+	}
+
+	return nil
+}
+
+// copyFile copies the contents of a file to a hash function.
+func copyFile(hashFunc hash.Hash, f *os.File) (int64, error) {
+	// An os.File implements io.WriterTo, which would make io.CopyBuffer ignore the buffer.
+	// So, only the io.Reader interface of the file is passed to io.CopyBuffer.
+	return io.CopyBuffer(hashFunc, struct{ io.Reader }{f}, make([]byte, fileBufferSize))
 }
@@ -0,0 +1,252 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.1.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//    2026-10-16: V1.1.0: Add aliases for Grøstl-224 and Grøstl-384.
+//
+
+package hashfactory
+
+import (
+	"cmp"
+	"hashvalue/crc"
+	"hashvalue/stringhelper"
+	"slices"
+	"strings"
+)
+
+// ******** Private constants ********
+
+// maxSuggestions is the maximum number of suggestions for an unknown name.
+const maxSuggestions = 3
+
+// ******** Private types ********
+
+// suggestion is a known name with its edit distance to an unknown name.
+type suggestion struct {
+	name     string
+	distance int
+}
+
+// ******** Private variables ********
+
+// aliasToName maps the alias names of algorithms to their names.
+// The aliases are common spellings and the names used by OpenSSL and by the Java Cryptography Architecture.
+// The object identifiers and the aliases of the CRC catalogue are added on initialization.
+var aliasToName = map[string]string{
+	`sha`:                 `sha1`,
+	`sha-1`:               `sha1`,
+	`sha-160`:             `sha1`,
+	`sha224`:              `sha2-224`,
+	`sha-224`:             `sha2-224`,
+	`sha256`:              `sha2-256`,
+	`sha-256`:             `sha2-256`,
+	`sha384`:              `sha2-384`,
+	`sha-384`:             `sha2-384`,
+	`sha512`:              `sha2-512`,
+	`sha-512`:             `sha2-512`,
+	`sha512-224`:          `sha2-512_224`,
+	`sha512/224`:          `sha2-512_224`,
+	`sha-512/224`:         `sha2-512_224`,
+	`sha2-512/224`:        `sha2-512_224`,
+	`sha512_224`:          `sha2-512_224`,
+	`sha512-256`:          `sha2-512_256`,
+	`sha512/256`:          `sha2-512_256`,
+	`sha-512/256`:         `sha2-512_256`,
+	`sha2-512/256`:        `sha2-512_256`,
+	`sha512_256`:          `sha2-512_256`,
+	`sha3_224`:            `sha3-224`,
+	`sha3_256`:            `sha3-256`,
+	`sha3_384`:            `sha3-384`,
+	`sha3_512`:            `sha3-512`,
+	`shake-128`:           `shake128`,
+	`shake-256`:           `shake256`,
+	`shake_128`:           `shake128`,
+	`shake_256`:           `shake256`,
+	`keccak256`:           `keccak-256`,
+	`keccak512`:           `keccak-512`,
+	`kmac-128`:            `kmac128`,
+	`kmac-256`:            `kmac256`,
+	`ripemd`:              `ripemd-160`,
+	`ripemd160`:           `ripemd-160`,
+	`rmd160`:              `ripemd-160`,
+	`md-4`:                `md4`,
+	`md-5`:                `md5`,
+	`blake2b`:             `blake2b-512`,
+	`blake2b256`:          `blake2b-256`,
+	`blake2b384`:          `blake2b-384`,
+	`blake2b512`:          `blake2b-512`,
+	`blake2s`:             `blake2s-256`,
+	`blake2s128`:          `blake2s-128`,
+	`blake2s256`:          `blake2s-256`,
+	`blake3-256`:          `blake3`,
+	`md_gost12_256`:       `streebog-256`,
+	`md_gost12_512`:       `streebog-512`,
+	`gost3411-2012-256`:   `streebog-256`,
+	`gost3411-2012-512`:   `streebog-512`,
+	`stribog256`:          `streebog-256`,
+	`stribog512`:          `streebog-512`,
+	`streebog256`:         `streebog-256`,
+	`streebog512`:         `streebog-512`,
+	`sm3-256`:             `sm3`,
+	`tiger-192`:           `tiger`,
+	`tiger2-192`:          `tiger2`,
+	`grøstl-224`:          `groestl-224`,
+	`grøstl-256`:          `groestl-256`,
+	`grøstl-384`:          `groestl-384`,
+	`grøstl-512`:          `groestl-512`,
+	`groestl224`:          `groestl-224`,
+	`groestl256`:          `groestl-256`,
+	`groestl384`:          `groestl-384`,
+	`groestl512`:          `groestl-512`,
+	`skein256`:            `skein-256`,
+	`skein512`:            `skein-512`,
+	`skein1024`:           `skein-1024`,
+	`skein-256-256`:       `skein-256`,
+	`skein-512-512`:       `skein-512`,
+	`skein-1024-1024`:     `skein-1024`,
+	`kangarootwelve`:      `k12`,
+	`kangaroo12`:          `k12`,
+	`ascon-hash`:          `ascon-hash256`,
+	`ascon-xof`:           `ascon-xof128`,
+	`ascon-cxof`:          `ascon-cxof128`,
+	`cmac`:                `aes-cmac`,
+	`aescmac`:             `aes-cmac`,
+	`gmac`:                `aes-gmac`,
+	`aesgmac`:             `aes-gmac`,
+	`siphash`:             `siphash-2-4`,
+	`siphash24`:           `siphash-2-4`,
+	`siphash13`:           `siphash-1-3`,
+	`adler-32`:            `adler32`,
+	`crc32`:               `crc32-ieee`,
+	`crc32-castagnoli`:    `crc32c`,
+	`crc64`:               `crc64-ecma`,
+	`fnv32`:               `fnv1-32`,
+	`fnv64`:               `fnv1-64`,
+	`fnv128`:              `fnv1-128`,
+	`fnv32a`:              `fnv1a-32`,
+	`fnv64a`:              `fnv1a-64`,
+	`fnv128a`:             `fnv1a-128`,
+	`murmur3`:             `murmur3-32`,
+	`murmur3_32`:          `murmur3-32`,
+	`murmur3_128`:         `murmur3-128`,
+	`murmur3_x86_32`:      `murmur3-32`,
+	`murmur3_x64_128`:     `murmur3-128`,
+	`murmurhash3_x86_32`:  `murmur3-32`,
+	`murmurhash3_x64_128`: `murmur3-128`,
+	`xxhash32`:            `xxh32`,
+	`xxhash64`:            `xxh64`,
+	`xxhash`:              `xxh64`,
+	`xxh3`:                `xxh3-64`,
+	`xxh3_64`:             `xxh3-64`,
+	`xxh3_128`:            `xxh3-128`,
+	`xxh128`:              `xxh3-128`,
+}
+
+// ******** Public functions ********
+
+// CanonicalName returns the name of the hash algorithm for a name, an alias or an object identifier.
+// The second return value is false, if the name is not known.
+func CanonicalName(hashAlgorithm string) (string, bool) {
+	name := canonicalName(hashAlgorithm)
+	_, ok := lookup(name)
+
+	return name, ok
+}
+
+// Suggestions returns the known names that are most similar to an unknown name.
+// Aliases are considered, but only the names of the algorithms are returned.
+// The result is empty, if no known name is similar enough.
+func Suggestions(hashAlgorithm string) []string {
+	name := normalizeName(hashAlgorithm)
+	maxDistance := max(1, len(name)/3)
+
+	bestDistance := make(map[string]int)
+	addCandidate := func(candidate string, canonical string) {
+		distance := stringhelper.EditDistance(name, candidate)
+		if distance > maxDistance {
+			return
+		}
+
+		best, found := bestDistance[canonical]
+		if !found || distance < best {
+			bestDistance[canonical] = distance
+		}
+	}
+
+	for knownName := range hashAlgorithmNameToAlgorithm {
+		addCandidate(knownName, knownName)
+	}
+
+	for alias, knownName := range aliasToName {
+		addCandidate(alias, knownName)
+	}
+
+	suggestions := make([]suggestion, 0, len(bestDistance))
+	for knownName, distance := range bestDistance {
+		suggestions = append(suggestions, suggestion{name: knownName, distance: distance})
+	}
+
+	slices.SortFunc(suggestions, func(a, b suggestion) int {
+		return cmp.Or(cmp.Compare(a.distance, b.distance), strings.Compare(a.name, b.name))
+	})
+
+	result := make([]string, 0, maxSuggestions)
+	for _, s := range suggestions[:min(len(suggestions), maxSuggestions)] {
+		result = append(result, s.name)
+	}
+
+	return result
+}
+
+// ******** Private functions ********
+
+// init adds the object identifiers and the aliases of the CRC catalogue to the aliases.
+func init() {
+	for name, oid := range nameToOID {
+		aliasToName[oid] = name
+	}
+
+	for alias, name := range crc.Aliases() {
+		aliasToName[strings.ToLower(alias)] = strings.ToLower(name)
+	}
+}
+
+// normalizeName returns the name in lower case without leading and trailing white space.
+func normalizeName(hashAlgorithm string) string {
+	return strings.ToLower(strings.TrimSpace(hashAlgorithm))
+}
+
+// canonicalName returns the normalized name of the hash algorithm with the aliases resolved.
+func canonicalName(hashAlgorithm string) string {
+	name := normalizeName(hashAlgorithm)
+
+	knownName, isAlias := aliasToName[name]
+	if isAlias {
+		return knownName
+	}
+
+	return name
+}
@@ -0,0 +1,150 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.2.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//    2026-10-16: V1.1.0: Add xxHash functions.
+//    2026-10-16: V1.2.0: Add MurmurHash3 functions.
+//
+
+package hashfactory
+
+import (
+	"hash"
+	"hash/adler32"
+	"hash/crc32"
+	"hash/crc64"
+	"hash/fnv"
+	"hashvalue/murmur3"
+	"hashvalue/xxhash"
+	"math"
+)
+
+// This file contains the creation functions of the non-cryptographic checksums.
+// They are needed as the standard library functions return more specific interfaces
+// than hash.Hash, or need a table or a seed.
+
+// ******** Private variables ********
+
+// Tables for the CRC functions.
+var (
+	crc32CastagnoliTable = crc32.MakeTable(crc32.Castagnoli)
+	crc32KoopmanTable    = crc32.MakeTable(crc32.Koopman)
+	crc64ISOTable        = crc64.MakeTable(crc64.ISO)
+	crc64ECMATable       = crc64.MakeTable(crc64.ECMA)
+)
+
+// ******** Private functions ********
+
+// newAdler32 creates an Adler-32 checksum.
+func newAdler32() hash.Hash {
+	return adler32.New()
+}
+
+// newCRC32IEEE creates a CRC-32 checksum with the IEEE polynomial.
+func newCRC32IEEE() hash.Hash {
+	return crc32.NewIEEE()
+}
+
+// newCRC32C creates a CRC-32 checksum with the Castagnoli polynomial.
+func newCRC32C() hash.Hash {
+	return crc32.New(crc32CastagnoliTable)
+}
+
+// newCRC32Koopman creates a CRC-32 checksum with the Koopman polynomial.
+func newCRC32Koopman() hash.Hash {
+	return crc32.New(crc32KoopmanTable)
+}
+
+// newCRC64ISO creates a CRC-64 checksum with the ISO polynomial.
+func newCRC64ISO() hash.Hash {
+	return crc64.New(crc64ISOTable)
+}
+
+// newCRC64ECMA creates a CRC-64 checksum with the ECMA polynomial.
+func newCRC64ECMA() hash.Hash {
+	return crc64.New(crc64ECMATable)
+}
+
+// newFNV1_32 creates a 32-bit FNV-1 hash.
+func newFNV1_32() hash.Hash {
+	return fnv.New32()
+}
+
+// newFNV1a_32 creates a 32-bit FNV-1a hash.
+func newFNV1a_32() hash.Hash {
+	return fnv.New32a()
+}
+
+// newFNV1_64 creates a 64-bit FNV-1 hash.
+func newFNV1_64() hash.Hash {
+	return fnv.New64()
+}
+
+// newFNV1a_64 creates a 64-bit FNV-1a hash.
+func newFNV1a_64() hash.Hash {
+	return fnv.New64a()
+}
+
+// newXXH32 creates an XXH32 hash function. Its seed has only 32 bits.
+func newXXH32(seed uint64) (hash.Hash, error) {
+	if seed > math.MaxUint32 {
+		return nil, errSeedTooLarge
+	}
+
+	return xxhash.New32(uint32(seed)), nil
+}
+
+// newXXH64 creates an XXH64 hash function.
+func newXXH64(seed uint64) (hash.Hash, error) {
+	return xxhash.New64(seed), nil
+}
+
+// newXXH3_64 creates an XXH3 hash function with a 64 bit output.
+func newXXH3_64(seed uint64) (hash.Hash, error) {
+	return xxhash.New3(seed), nil
+}
+
+// newXXH3_128 creates an XXH3 hash function with a 128 bit output.
+func newXXH3_128(seed uint64) (hash.Hash, error) {
+	return xxhash.New128(seed), nil
+}
+
+// newMurmur3_32 creates a MurmurHash3 x86_32 hash function. Its seed has only 32 bits.
+func newMurmur3_32(seed uint64) (hash.Hash, error) {
+	if seed > math.MaxUint32 {
+		return nil, errSeedTooLarge
+	}
+
+	return murmur3.New32(uint32(seed)), nil
+}
+
+// newMurmur3_128 creates a MurmurHash3 x64_128 hash function. Its seed has only 32 bits.
+func newMurmur3_128(seed uint64) (hash.Hash, error) {
+	if seed > math.MaxUint32 {
+		return nil, errSeedTooLarge
+	}
+
+	return murmur3.New128(uint32(seed)), nil
+}
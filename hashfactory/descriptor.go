@@ -0,0 +1,256 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.2.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//    2026-10-16: V1.1.0: Lookup accepts aliases and object identifiers.
+//    2026-10-16: V1.2.0: Add the security status "broken". Take the security status from the algorithm.
+//
+
+package hashfactory
+
+import (
+	"strings"
+)
+
+// ******** Public types ********
+
+// SecurityStatus is the security status of a hash algorithm.
+type SecurityStatus string
+
+// These are the possible security statuses.
+const (
+	// SecurityStatusSecure is the status of a cryptographic algorithm that can be used for new applications.
+	SecurityStatusSecure SecurityStatus = `secure`
+
+	// SecurityStatusBroken is the status of a cryptographic algorithm with practical collision attacks.
+	// It must not be used where collision resistance is needed, e.g. for signatures or certificates.
+	// It is still widely used for checking data integrity and in HMACs.
+	SecurityStatusBroken SecurityStatus = `broken`
+
+	// SecurityStatusLegacy is the status of a cryptographic algorithm that should only be used
+	// for compatibility with existing data.
+	SecurityStatusLegacy SecurityStatus = `legacy`
+
+	// SecurityStatusChecksum is the status of a non-cryptographic checksum.
+	SecurityStatusChecksum SecurityStatus = `checksum`
+)
+
+// Descriptor describes the properties of a hash algorithm.
+type Descriptor struct {
+	// Name is the name of the algorithm.
+	Name string `json:"name"`
+
+	// Family is the name of the family the algorithm belongs to.
+	Family string `json:"family"`
+
+	// DigestSize is the default digest size in bytes.
+	DigestSize int `json:"digestSize"`
+
+	// BlockSize is the block size in bytes.
+	BlockSize int `json:"blockSize"`
+
+	// Security is the security status.
+	Security SecurityStatus `json:"security"`
+
+	// OID is the object identifier in dot notation. It is empty, if the algorithm has no well-known object identifier.
+	OID string `json:"oid,omitempty"`
+
+	// KeySupported is true, if the algorithm can be used with a key, either natively or as an HMAC.
+	KeySupported bool `json:"keySupported"`
+
+	// KeyRequired is true, if the algorithm can only be used with a key.
+	KeyRequired bool `json:"keyRequired"`
+
+	// VariableOutputLength is true, if the output length can be specified.
+	VariableOutputLength bool `json:"variableOutputLength"`
+}
+
+// ******** Private constants ********
+
+// probeKeySize is the key size in bytes that is used to create an instance of an algorithm that needs a key
+// and accepts keys of any size.
+const probeKeySize = 32
+
+// probeNonceSize is the nonce size in bytes that is used to create an instance of an algorithm that needs a nonce.
+const probeNonceSize = 12
+
+// ******** Private variables ********
+
+// familyNames contains the names of the algorithm families.
+// The family of an algorithm is the family name that is a prefix of the algorithm name.
+var familyNames = []string{
+	`adler32`,
+	`aes-cmac`,
+	`aes-gmac`,
+	`ascon`,
+	`blake2b`,
+	`blake2s`,
+	`blake3`,
+	`crc`,
+	`cshake`,
+	`fnv`,
+	`groestl`,
+	`k12`,
+	`keccak`,
+	`kmac`,
+	`md4`,
+	`md5`,
+	`murmur3`,
+	`parallelhash`,
+	`poly1305`,
+	`ripemd`,
+	`sha1`,
+	`sha2`,
+	`sha3`,
+	`shake`,
+	`siphash`,
+	`skein`,
+	`sm3`,
+	`streebog`,
+	`tiger`,
+	`tuplehash`,
+	`turboshake`,
+	`whirlpool`,
+	`xxh`,
+}
+
+// nameToOID maps algorithm names to their well-known object identifiers.
+var nameToOID = map[string]string{
+	`md4`:          `1.2.840.113549.2.4`,
+	`md5`:          `1.2.840.113549.2.5`,
+	`sha1`:         `1.3.14.3.2.26`,
+	`ripemd-160`:   `1.3.36.3.2.1`,
+	`tiger`:        `1.3.6.1.4.1.11591.12.2`,
+	`whirlpool`:    `1.0.10118.3.0.55`,
+	`sha2-256`:     `2.16.840.1.101.3.4.2.1`,
+	`sha2-384`:     `2.16.840.1.101.3.4.2.2`,
+	`sha2-512`:     `2.16.840.1.101.3.4.2.3`,
+	`sha2-224`:     `2.16.840.1.101.3.4.2.4`,
+	`sha2-512_224`: `2.16.840.1.101.3.4.2.5`,
+	`sha2-512_256`: `2.16.840.1.101.3.4.2.6`,
+	`sha3-224`:     `2.16.840.1.101.3.4.2.7`,
+	`sha3-256`:     `2.16.840.1.101.3.4.2.8`,
+	`sha3-384`:     `2.16.840.1.101.3.4.2.9`,
+	`sha3-512`:     `2.16.840.1.101.3.4.2.10`,
+	`shake128`:     `2.16.840.1.101.3.4.2.11`,
+	`shake256`:     `2.16.840.1.101.3.4.2.12`,
+	`kmac128`:      `2.16.840.1.101.3.4.2.19`,
+	`kmac256`:      `2.16.840.1.101.3.4.2.20`,
+	`blake2b-160`:  `1.3.6.1.4.1.1722.12.2.1.5`,
+	`blake2b-256`:  `1.3.6.1.4.1.1722.12.2.1.8`,
+	`blake2b-384`:  `1.3.6.1.4.1.1722.12.2.1.12`,
+	`blake2b-512`:  `1.3.6.1.4.1.1722.12.2.1.16`,
+	`blake2s-128`:  `1.3.6.1.4.1.1722.12.2.2.4`,
+	`blake2s-160`:  `1.3.6.1.4.1.1722.12.2.2.5`,
+	`blake2s-224`:  `1.3.6.1.4.1.1722.12.2.2.7`,
+	`blake2s-256`:  `1.3.6.1.4.1.1722.12.2.2.8`,
+	`sm3`:          `1.2.156.10197.1.401`,
+	`streebog-256`: `1.2.643.7.1.1.2.2`,
+	`streebog-512`: `1.2.643.7.1.1.2.3`,
+}
+
+// ******** Public functions ********
+
+// Lookup returns the descriptor of the hash algorithm with the given name, alias or object identifier.
+// It returns false, if the name is not known.
+func Lookup(hashAlgorithm string) (Descriptor, bool) {
+	a, ok := lookup(hashAlgorithm)
+	if !ok {
+		return Descriptor{}, false
+	}
+
+	return a.describe(canonicalName(hashAlgorithm)), true
+}
+
+// Descriptors returns the descriptors of all known hash algorithms sorted by name.
+func Descriptors() []Descriptor {
+	names := KnownHashNames()
+
+	result := make([]Descriptor, len(names))
+	for i, name := range names {
+		result[i], _ = Lookup(name)
+	}
+
+	return result
+}
+
+// ******** Private functions ********
+
+// describe creates the descriptor of the algorithm with the given name.
+// The sizes are taken from an instance that is created with the default parameters.
+func (a *algorithm) describe(name string) Descriptor {
+	result := Descriptor{
+		Name:                 name,
+		Family:               familyOf(name),
+		Security:             a.security,
+		OID:                  nameToOID[name],
+		KeySupported:         a.usage&(usesHMAC|usesKey) != 0,
+		KeyRequired:          a.usage&needsKey != 0,
+		VariableOutputLength: a.usage&usesOutputLength != 0,
+	}
+
+	hashFunc, err := a.create(a.probeParameters())
+	if err == nil {
+		result.DigestSize = hashFunc.Size()
+		result.BlockSize = hashFunc.BlockSize()
+	}
+
+	return result
+}
+
+// probeParameters returns the parameters that are needed to create an instance of the algorithm.
+func (a *algorithm) probeParameters() *Parameters {
+	if a.usage&(needsKey|needsNonce) == 0 {
+		return noParameters
+	}
+
+	result := &Parameters{}
+
+	if a.usage&needsKey != 0 {
+		keySize := a.keySize
+		if keySize == 0 {
+			keySize = probeKeySize
+		}
+
+		result.Key = make([]byte, keySize)
+	}
+
+	if a.usage&needsNonce != 0 {
+		result.Nonce = make([]byte, probeNonceSize)
+	}
+
+	return result
+}
+
+// familyOf returns the family name of an algorithm.
+func familyOf(name string) string {
+	for _, family := range familyNames {
+		if strings.HasPrefix(name, family) {
+			return family
+		}
+	}
+
+	return name
+}
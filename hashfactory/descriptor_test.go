@@ -0,0 +1,96 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package hashfactory
+
+import (
+	"testing"
+)
+
+// ******** Private types ********
+
+// securityExpectation is the expected security status of an algorithm.
+type securityExpectation struct {
+	name     string
+	security SecurityStatus
+	isLegacy bool
+}
+
+// ******** Private variables ********
+
+// securityExpectations contains the expected security statuses of some algorithms.
+var securityExpectations = []securityExpectation{
+	{name: `md4`, security: SecurityStatusLegacy, isLegacy: true},
+	{name: `ripemd-160`, security: SecurityStatusLegacy, isLegacy: true},
+	{name: `whirlpool`, security: SecurityStatusLegacy, isLegacy: true},
+	{name: `md5`, security: SecurityStatusBroken, isLegacy: false},
+	{name: `sha1`, security: SecurityStatusBroken, isLegacy: false},
+	{name: `sha2-256`, security: SecurityStatusSecure, isLegacy: false},
+	{name: `sha3-512`, security: SecurityStatusSecure, isLegacy: false},
+	{name: `blake2b-160`, security: SecurityStatusSecure, isLegacy: false},
+	{name: `kmac128`, security: SecurityStatusSecure, isLegacy: false},
+	{name: `adler32`, security: SecurityStatusChecksum, isLegacy: false},
+	{name: `xxh64`, security: SecurityStatusChecksum, isLegacy: false},
+	{name: `crc-32/iso-hdlc`, security: SecurityStatusChecksum, isLegacy: false},
+}
+
+// ******** Test functions ********
+
+// TestSecurityStatus tests the security statuses of some algorithms.
+func TestSecurityStatus(t *testing.T) {
+	for _, e := range securityExpectations {
+		d, ok := Lookup(e.name)
+		if !ok {
+			t.Fatalf(`%s: algorithm is not known`, e.name)
+		}
+
+		if d.Security != e.security {
+			t.Errorf(`%s: got security status %s, expected %s`, e.name, d.Security, e.security)
+		}
+
+		if IsLegacy(e.name) != e.isLegacy {
+			t.Errorf(`%s: got IsLegacy %t, expected %t`, e.name, !e.isLegacy, e.isLegacy)
+		}
+
+		if IsCryptographic(e.name) != (e.security != SecurityStatusChecksum) {
+			t.Errorf(`%s: got IsCryptographic %t for security status %s`, e.name, IsCryptographic(e.name), e.security)
+		}
+	}
+}
+
+// TestAllSecurityStatusesSet tests that every algorithm has one of the defined security statuses.
+func TestAllSecurityStatusesSet(t *testing.T) {
+	for _, d := range Descriptors() {
+		switch d.Security {
+		case SecurityStatusSecure, SecurityStatusBroken, SecurityStatusLegacy, SecurityStatusChecksum:
+
+		default:
+			t.Errorf(`%s: invalid security status "%s"`, d.Name, d.Security)
+		}
+	}
+}
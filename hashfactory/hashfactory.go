@@ -1,5 +1,5 @@
 //
-// SPDX-FileCopyrightText: Copyright 2024-2025 Frank Schwab
+// SPDX-FileCopyrightText: Copyright 2024-2026 Frank Schwab
 //
 // SPDX-License-Identifier: Apache-2.0
 //
@@ -20,7 +20,7 @@
 //
 // Author: Frank Schwab
 //
-// Version: 4.2.0
+// Version: 6.20.0
 //
 // Change history:
 //    2024-12-20: V1.0.0: Created.
@@ -30,45 +30,318 @@
 //    2025-02-26: V4.0.0: No longer return normalized hash type name.
 //    2025-03-02: V4.1.0: Remove conversion no longer necessary.
 //    2025-04-17: V4.2.0: Change names from "hash type" to "hash algorithm".
+//    2026-10-16: V4.3.0: Add HMAC creation.
+//    2026-10-16: V5.0.0: Use native keyed mode for Blake2x MACs. Add "blake2s-128" as a MAC-only algorithm.
+//    2026-10-16: V6.0.0: Use parameters for hash creation. Add "shake128" and "shake256".
+//    2026-10-16: V6.1.0: Add cSHAKE and KMAC functions.
+//    2026-10-16: V6.2.0: Add TupleHash and ParallelHash functions.
+//    2026-10-16: V6.3.0: Add BLAKE3.
+//    2026-10-16: V6.4.0: Add non-cryptographic checksums.
+//    2026-10-16: V6.5.0: Add catalogue of named CRCs and custom CRCs.
+//    2026-10-16: V6.6.0: Add xxHash functions and seed parameter.
+//    2026-10-16: V6.7.0: Add MurmurHash3 and SipHash functions.
+//    2026-10-16: V6.8.0: Add legacy hash functions.
+//    2026-10-16: V6.9.0: Add Keccak functions with the original padding.
+//    2026-10-16: V6.10.0: Add SM3 and Streebog.
+//    2026-10-16: V6.11.0: Add KangarooTwelve and TurboSHAKE.
+//    2026-10-16: V6.12.0: Add Ascon hash functions.
+//    2026-10-16: V6.13.0: Add Skein and Grøstl.
+//    2026-10-16: V6.14.0: Use own BLAKE2 implementation with any digest size, salt and personalization.
+//    2026-10-16: V6.15.0: Add AES-CMAC, AES-GMAC and Poly1305.
+//    2026-10-16: V6.16.0: Add algorithm descriptors.
+//    2026-10-16: V6.17.0: Add aliases.
+//    2026-10-16: V6.18.0: Add creation function of the hash function for HMAC.
+//    2026-10-16: V6.18.1: Do not return a typed nil for an invalid BLAKE3 key.
+//    2026-10-16: V6.18.2: Do not return a typed nil for an invalid CRC model.
+//    2026-10-16: V6.18.3: Do not return a typed nil for an invalid SipHash key.
+//    2026-10-16: V6.18.4: MD5 and SHA-1 are no longer marked as legacy.
+//    2026-10-16: V6.18.5: Do not return a typed nil for a too long Ascon-CXOF128 customization.
+//    2026-10-16: V6.18.6: Do not return a typed nil for invalid BLAKE2 parameters.
+//    2026-10-16: V6.18.7: Do not return a typed nil for invalid MAC keys or nonces.
+//    2026-10-16: V6.18.8: Check the maximum block size.
+//    2026-10-16: V6.19.0: Add Grøstl-224 and Grøstl-384.
+//    2026-10-16: V6.20.0: Set the security status explicitly for each algorithm. MD5 and SHA-1 are classified as broken.
 //
 
 // Package hashfactory implements the hash factory functions.
 package hashfactory
 
 import (
+	"crypto/hmac"
 	"crypto/md5"
 	"crypto/sha1"
 	"crypto/sha256"
 	"crypto/sha512"
-	"golang.org/x/crypto/blake2b"
-	"golang.org/x/crypto/blake2s"
+	"errors"
+	"golang.org/x/crypto/md4"
+	"golang.org/x/crypto/ripemd160"
 	"golang.org/x/crypto/sha3"
 	"hash"
+	"hash/fnv"
+	"hashvalue/ascon"
+	"hashvalue/blake2"
+	"hashvalue/blake3"
+	"hashvalue/crc"
+	"hashvalue/groestl"
+	"hashvalue/k12"
+	"hashvalue/mac"
+	"hashvalue/siphash"
+	"hashvalue/skein"
+	"hashvalue/sm3"
+	"hashvalue/streebog"
+	"hashvalue/tiger"
+	"hashvalue/whirlpool"
 	"slices"
+	"strconv"
+	"strings"
 )
 
+// ******** Public types ********
+
+// Parameters contains the parameters for the creation of a hash function.
+type Parameters struct {
+	// Key is the key of a keyed hash function. It is empty for an unkeyed hash function.
+	Key []byte
+
+	// OutputLength is the output length in bytes. It is 0, if the default output length is to be used.
+	OutputLength int
+
+	// Customization is the customization string of a customizable hash function.
+	Customization []byte
+
+	// FunctionName is the function name string of a customizable hash function.
+	FunctionName []byte
+
+	// BlockSize is the block size in bytes of a hash function that hashes blocks in parallel.
+	// It is 0, if the default block size is to be used. It must not be larger than MaxBlockSize.
+	BlockSize int
+
+	// Context is the context string of a key derivation function.
+	Context []byte
+
+	// Seed is the seed of a seeded non-cryptographic hash function. 0 is the default seed.
+	Seed uint64
+
+	// Salt is the salt of a hash function that can be salted.
+	Salt []byte
+
+	// Personalization is the personalization string of a hash function that can be personalized.
+	Personalization []byte
+
+	// Nonce is the nonce of a message authentication code that needs one.
+	Nonce []byte
+}
+
+// ******** Public constants ********
+
+// MaxBlockSize is the maximum block size in bytes of a hash function that hashes blocks in parallel.
+const MaxBlockSize = 16 * 1024 * 1024
+
+// ******** Public variables ********
+
+// ErrUnknownAlgorithm is returned when a hash algorithm name is not known.
+var ErrUnknownAlgorithm = errors.New(`unknown hash algorithm`)
+
+// ErrHMACNotSupported is returned when a hash algorithm can not be used with HMAC.
+var ErrHMACNotSupported = errors.New(`hash algorithm can not be used with HMAC`)
+
+// ******** Private constants ********
+
+// aesKeySize is the smallest AES key size in bytes.
+const aesKeySize = 16
+
+// ******** Private types ********
+
+// parameterUsage specifies how an algorithm uses the parameters.
+type parameterUsage uint
+
+// These are the possible parameter usages. They can be combined.
+const (
+	// usesHMAC means that a key is used in an HMAC construction.
+	usesHMAC parameterUsage = 1 << iota
+
+	// usesKey means that a key is used by the algorithm itself.
+	usesKey
+
+	// needsKey means that the algorithm can only be used with a key.
+	needsKey
+
+	// usesOutputLength means that the output length can be specified.
+	usesOutputLength
+
+	// usesCustomization means that a customization string can be specified.
+	usesCustomization
+
+	// usesFunctionName means that a function name string can be specified.
+	usesFunctionName
+
+	// usesBlockSize means that a block size can be specified.
+	usesBlockSize
+
+	// usesContext means that a context string for key derivation can be specified.
+	usesContext
+
+	// usesSeed means that a seed can be specified.
+	usesSeed
+
+	// usesSalt means that a salt can be specified.
+	usesSalt
+
+	// usesPersonalization means that a personalization string can be specified.
+	usesPersonalization
+
+	// usesNonce means that a nonce can be specified.
+	usesNonce
+
+	// needsNonce means that the algorithm can only be used with a nonce.
+	needsNonce
+)
+
+// algorithm contains the creation function of a hash algorithm and the parameters it uses.
+type algorithm struct {
+	// create creates the hash function with the supplied parameters.
+	create func(p *Parameters) (hash.Hash, error)
+
+	// usage specifies which parameters are used.
+	usage parameterUsage
+
+	// security is the security status of the algorithm. It is set explicitly for each algorithm.
+	security SecurityStatus
+
+	// keySize is the key size in bytes of an algorithm that needs a key and only accepts specific key sizes.
+	// It is used to create an instance for the descriptor. 0 means that keys of any size are accepted.
+	keySize int
+}
+
 // ******** Private variables ********
 
-// hashAlgorithmNameToFunction maps the hash algorithm name to the hash creation function.
-var hashAlgorithmNameToFunction = make(map[string]func() hash.Hash)
+// hashAlgorithmNameToAlgorithm maps the hash algorithm name to the algorithm.
+var hashAlgorithmNameToAlgorithm = make(map[string]*algorithm)
+
+// noParameters is an empty parameter set.
+var noParameters = &Parameters{}
+
+// Parameter errors.
+var (
+	errKeyNotSupported             = errors.New(`a key is not supported`)
+	errKeyRequired                 = errors.New(`a key is required`)
+	errOutputLengthNotSupported    = errors.New(`an output length is not supported`)
+	errOutputLengthNegative        = errors.New(`output length must not be negative`)
+	errCustomizationNotSupported   = errors.New(`a customization string is not supported`)
+	errFunctionNameNotSupported    = errors.New(`a function name is not supported`)
+	errBlockSizeNotSupported       = errors.New(`a block size is not supported`)
+	errBlockSizeNegative           = errors.New(`block size must not be negative`)
+	errBlockSizeTooLarge           = errors.New(`block size must not be larger than 16777216 bytes`)
+	errContextNotSupported         = errors.New(`a context string is not supported`)
+	errKeyAndContext               = errors.New(`a key and a context string can not be used together`)
+	errSeedNotSupported            = errors.New(`a seed is not supported`)
+	errSeedTooLarge                = errors.New(`seed must not be larger than 32 bits`)
+	errSaltNotSupported            = errors.New(`a salt is not supported`)
+	errPersonalizationNotSupported = errors.New(`a personalization string is not supported`)
+	errNonceNotSupported           = errors.New(`a nonce is not supported`)
+	errNonceRequired               = errors.New(`a nonce is required`)
+)
 
 // ******** Public functions ********
 
-// New creates a hash function from the hash algorithm name.
+// New creates a hash function with default parameters from the hash algorithm name.
 func New(hashAlgorithm string) (hash.Hash, bool) {
-	hashCreationFunction, ok := hashAlgorithmNameToFunction[hashAlgorithm]
+	hashFunc, err := NewWithParameters(hashAlgorithm, noParameters)
 
-	if ok {
-		return hashCreationFunction(), ok
-	} else {
-		return nil, ok
+	return hashFunc, err == nil
+}
+
+// NewMAC creates a keyed hash function from the hash algorithm name and the key.
+// Algorithms that have a native keyed mode use that mode. All other algorithms use HMAC.
+// An error is returned if the algorithm is unknown or the key is not valid for the algorithm.
+func NewMAC(hashAlgorithm string, key []byte) (hash.Hash, error) {
+	return NewWithParameters(hashAlgorithm, &Parameters{Key: key})
+}
+
+// NewHMACHash returns the creation function of the unkeyed hash function that HMAC uses for the hash algorithm.
+// It can be used by constructions on top of HMAC, like HKDF.
+// An error is returned if the algorithm is unknown or can not be used with HMAC.
+func NewHMACHash(hashAlgorithm string) (func() hash.Hash, error) {
+	a, ok := lookup(hashAlgorithm)
+	if !ok {
+		return nil, ErrUnknownAlgorithm
 	}
+
+	if a.usage&usesHMAC == 0 {
+		return nil, ErrHMACNotSupported
+	}
+
+	return a.newUnkeyed, nil
+}
+
+// NewWithParameters creates a hash function from the hash algorithm name and the parameters.
+// An error is returned if the algorithm is unknown or the parameters are not valid for the algorithm.
+func NewWithParameters(hashAlgorithm string, p *Parameters) (hash.Hash, error) {
+	a, ok := lookup(hashAlgorithm)
+	if !ok {
+		return nil, ErrUnknownAlgorithm
+	}
+
+	err := a.checkParameters(p)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(p.Key) != 0 && a.usage&usesHMAC != 0 {
+		return hmac.New(a.newUnkeyed, p.Key), nil
+	}
+
+	return a.create(p)
+}
+
+// NewCustomCRC creates a CRC from a parameter specification as described for [crc.ParseModel].
+// An error is returned if the specification or the parameters are not valid.
+func NewCustomCRC(spec string, p *Parameters) (hash.Hash, error) {
+	model, err := crc.ParseModel(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	a := newCRCAlgorithm(model)
+
+	err = a.checkParameters(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.create(p)
+}
+
+// IsKnown returns true, if the hash algorithm name is known.
+func IsKnown(hashAlgorithm string) bool {
+	_, ok := lookup(hashAlgorithm)
+
+	return ok
+}
+
+// IsCryptographic returns true, if the hash algorithm is a cryptographic hash function.
+// It returns false, if the algorithm is a non-cryptographic checksum or if it is not known.
+func IsCryptographic(hashAlgorithm string) bool {
+	a, ok := lookup(hashAlgorithm)
+
+	return ok && a.security != SecurityStatusChecksum
+}
+
+// IsLegacy returns true, if the hash algorithm has the security status SecurityStatusLegacy,
+// i.e. it should only be used for compatibility with existing data.
+// It returns false for all other security statuses, including SecurityStatusBroken,
+// and if the algorithm is not known.
+func IsLegacy(hashAlgorithm string) bool {
+	a, ok := lookup(hashAlgorithm)
+
+	return ok && a.security == SecurityStatusLegacy
 }
 
 // KnownHashNames returns an array of valid known names.
+// The BLAKE2 functions are only listed with their common digest sizes.
 func KnownHashNames() []string {
-	result := make([]string, 0, len(hashAlgorithmNameToFunction))
-	for name := range hashAlgorithmNameToFunction {
+	result := make([]string, 0, len(hashAlgorithmNameToAlgorithm))
+	for name := range hashAlgorithmNameToAlgorithm {
 		result = append(result, name)
 	}
 
@@ -81,52 +354,496 @@ func KnownHashNames() []string {
 
 // init is the package initialization function.
 func init() {
-	hashAlgorithmNameToFunction[`md5`] = md5.New
-	hashAlgorithmNameToFunction[`sha1`] = sha1.New
-	hashAlgorithmNameToFunction[`sha2-224`] = sha256.New224
-	hashAlgorithmNameToFunction[`sha2-256`] = sha256.New
-	hashAlgorithmNameToFunction[`sha2-384`] = sha512.New384
-	hashAlgorithmNameToFunction[`sha2-512`] = sha512.New
-	hashAlgorithmNameToFunction[`sha2-512_224`] = sha512.New512_224
-	hashAlgorithmNameToFunction[`sha2-512_256`] = sha512.New512_256
-	hashAlgorithmNameToFunction[`sha3-224`] = sha3.New224
-	hashAlgorithmNameToFunction[`sha3-256`] = sha3.New256
-	hashAlgorithmNameToFunction[`sha3-384`] = sha3.New384
-	hashAlgorithmNameToFunction[`sha3-512`] = sha3.New512
-	hashAlgorithmNameToFunction[`blake2b-256`] = newBlake2b_256
-	hashAlgorithmNameToFunction[`blake2b-384`] = newBlake2b_384
-	hashAlgorithmNameToFunction[`blake2b-512`] = newBlake2b_512
-	hashAlgorithmNameToFunction[`blake2s-256`] = newBlake2s_256
-}
-
-// -------- Hash helper functions --------
-// These helpers encapsulate the strange interface of the Blake2x functions
-// to look like the interface of the other hash functions.
-// The only error that can be returned is a "key too long" error, which can not
-// happen here, as there is no key.
-// A proper interface would have had two different "New" functions. One for the hash
-// and one for the MAC.
-
-// newBlake2b_256 creates a Blake2b-256 hash function.
-func newBlake2b_256() hash.Hash {
-	hashFunc, _ := blake2b.New256(nil)
-	return hashFunc
+	// Legacy hash functions.
+	registerHash(`md4`, md4.New, SecurityStatusLegacy)
+	registerHash(`ripemd-160`, ripemd160.New, SecurityStatusLegacy)
+	registerHash(`tiger`, tiger.New, SecurityStatusLegacy)
+	registerHash(`tiger2`, tiger.New2, SecurityStatusLegacy)
+	registerHash(`whirlpool`, whirlpool.New, SecurityStatusLegacy)
+
+	// Hash functions with practical collision attacks.
+	registerHash(`md5`, md5.New, SecurityStatusBroken)
+	registerHash(`sha1`, sha1.New, SecurityStatusBroken)
+
+	registerHash(`sha2-224`, sha256.New224, SecurityStatusSecure)
+	registerHash(`sha2-256`, sha256.New, SecurityStatusSecure)
+	registerHash(`sha2-384`, sha512.New384, SecurityStatusSecure)
+	registerHash(`sha2-512`, sha512.New, SecurityStatusSecure)
+	registerHash(`sha2-512_224`, sha512.New512_224, SecurityStatusSecure)
+	registerHash(`sha2-512_256`, sha512.New512_256, SecurityStatusSecure)
+	registerHash(`sha3-224`, sha3.New224, SecurityStatusSecure)
+	registerHash(`sha3-256`, sha3.New256, SecurityStatusSecure)
+	registerHash(`sha3-384`, sha3.New384, SecurityStatusSecure)
+	registerHash(`sha3-512`, sha3.New512, SecurityStatusSecure)
+	registerHash(`keccak-256`, sha3.NewLegacyKeccak256, SecurityStatusSecure)
+	registerHash(`keccak-512`, sha3.NewLegacyKeccak512, SecurityStatusSecure)
+	registerHash(`sm3`, sm3.New, SecurityStatusSecure)
+	registerHash(`streebog-256`, streebog.New256, SecurityStatusSecure)
+	registerHash(`streebog-512`, streebog.New512, SecurityStatusSecure)
+	registerXOF(`shake128`, sha3.NewShake128)
+	registerXOF(`shake256`, sha3.NewShake256)
+	registerCustomizable(`turboshake128`, newTurboSHAKE128, usesOutputLength)
+	registerCustomizable(`turboshake256`, newTurboSHAKE256, usesOutputLength)
+	registerCustomizable(`k12`, newK12, usesOutputLength|usesCustomization)
+	registerHash(`ascon-hash256`, ascon.NewHash256, SecurityStatusSecure)
+	registerCustomizable(`ascon-xof128`, newAsconXOF128, usesOutputLength)
+	registerAlgorithm(`ascon-cxof128`, newAsconCXOF128, usesOutputLength|usesCustomization)
+	registerHash(`groestl-224`, groestl.New224, SecurityStatusSecure)
+	registerHash(`groestl-256`, groestl.New256, SecurityStatusSecure)
+	registerHash(`groestl-384`, groestl.New384, SecurityStatusSecure)
+	registerHash(`groestl-512`, groestl.New512, SecurityStatusSecure)
+	registerCustomizable(`skein-256`, newSkein256, usesKey|usesOutputLength)
+	registerCustomizable(`skein-512`, newSkein512, usesKey|usesOutputLength)
+	registerCustomizable(`skein-1024`, newSkein1024, usesKey|usesOutputLength)
+	registerCustomizable(`cshake128`, newCShake128, usesOutputLength|usesCustomization|usesFunctionName)
+	registerCustomizable(`cshake256`, newCShake256, usesOutputLength|usesCustomization|usesFunctionName)
+	registerCustomizable(`kmac128`, newKMAC128, usesKey|needsKey|usesOutputLength|usesCustomization)
+	registerCustomizable(`kmac256`, newKMAC256, usesKey|needsKey|usesOutputLength|usesCustomization)
+	registerCustomizable(`kmacxof128`, newKMACXOF128, usesKey|needsKey|usesOutputLength|usesCustomization)
+	registerCustomizable(`kmacxof256`, newKMACXOF256, usesKey|needsKey|usesOutputLength|usesCustomization)
+	registerCustomizable(`tuplehash128`, newTupleHash128, usesOutputLength|usesCustomization)
+	registerCustomizable(`tuplehash256`, newTupleHash256, usesOutputLength|usesCustomization)
+	registerCustomizable(`tuplehashxof128`, newTupleHashXOF128, usesOutputLength|usesCustomization)
+	registerCustomizable(`tuplehashxof256`, newTupleHashXOF256, usesOutputLength|usesCustomization)
+	registerCustomizable(`parallelhash128`, newParallelHash128, usesOutputLength|usesCustomization|usesBlockSize)
+	registerCustomizable(`parallelhash256`, newParallelHash256, usesOutputLength|usesCustomization|usesBlockSize)
+	registerCustomizable(`parallelhashxof128`, newParallelHashXOF128, usesOutputLength|usesCustomization|usesBlockSize)
+	registerCustomizable(`parallelhashxof256`, newParallelHashXOF256, usesOutputLength|usesCustomization|usesBlockSize)
+
+	// The BLAKE2 functions have a native keyed mode. All other digest sizes are created on demand.
+	registerBlake2(`blake2b-256`)
+	registerBlake2(`blake2b-384`)
+	registerBlake2(`blake2b-512`)
+	registerBlake2(`blake2s-128`)
+	registerBlake2(`blake2s-256`)
+
+	registerAlgorithm(`blake3`, newBlake3, usesKey|usesOutputLength|usesContext)
+
+	// The SipHash functions are pseudorandom functions that need a key.
+	registerKeyedHash(`siphash-1-3`, newSipHash13, siphash.KeySize)
+	registerKeyedHash(`siphash-2-4`, newSipHash24, siphash.KeySize)
+
+	// The message authentication codes need a key. GMAC also needs a nonce.
+	registerKeyedHash(`aes-cmac`, newAESCMAC, aesKeySize)
+	registerKeyedAlgorithm(`aes-gmac`, newAESGMAC, usesNonce|needsNonce, aesKeySize)
+	registerKeyedHash(`poly1305`, newPoly1305, mac.Poly1305KeySize)
+
+	// Non-cryptographic checksums.
+	registerChecksum(`adler32`, newAdler32)
+	registerChecksum(`crc32-ieee`, newCRC32IEEE)
+	registerChecksum(`crc32c`, newCRC32C)
+	registerChecksum(`crc32-koopman`, newCRC32Koopman)
+	registerChecksum(`crc64-ecma`, newCRC64ECMA)
+	registerChecksum(`crc64-iso`, newCRC64ISO)
+	registerChecksum(`fnv1-32`, newFNV1_32)
+	registerChecksum(`fnv1a-32`, newFNV1a_32)
+	registerChecksum(`fnv1-64`, newFNV1_64)
+	registerChecksum(`fnv1a-64`, newFNV1a_64)
+	registerChecksum(`fnv1-128`, fnv.New128)
+	registerChecksum(`fnv1a-128`, fnv.New128a)
+
+	registerSeededChecksum(`xxh32`, newXXH32)
+	registerSeededChecksum(`xxh64`, newXXH64)
+	registerSeededChecksum(`xxh3-64`, newXXH3_64)
+	registerSeededChecksum(`xxh3-128`, newXXH3_128)
+	registerSeededChecksum(`murmur3-32`, newMurmur3_32)
+	registerSeededChecksum(`murmur3-128`, newMurmur3_128)
+
+	// The catalogue of named CRCs.
+	for _, model := range crc.Catalogue() {
+		registerCRC(model)
+	}
 }
 
-// newBlake2b_384 creates a Blake2b-384 hash function.
-func newBlake2b_384() hash.Hash {
-	hashFunc, _ := blake2b.New384(nil)
-	return hashFunc
+// -------- Registration functions --------
+
+// registerHash registers a hash function with a fixed output length and the given security status
+// that uses HMAC when a key is specified.
+func registerHash(name string, newHash func() hash.Hash, security SecurityStatus) {
+	hashAlgorithmNameToAlgorithm[name] = &algorithm{
+		create: func(_ *Parameters) (hash.Hash, error) {
+			return newHash(), nil
+		},
+		usage:    usesHMAC,
+		security: security,
+	}
 }
 
-// newBlake2b_512 creates a Blake2b-512 hash function.
-func newBlake2b_512() hash.Hash {
-	hashFunc, _ := blake2b.New512(nil)
-	return hashFunc
+// registerChecksum registers a non-cryptographic checksum. Checksums can not be used with a key.
+func registerChecksum(name string, newChecksum func() hash.Hash) {
+	hashAlgorithmNameToAlgorithm[name] = &algorithm{
+		create: func(_ *Parameters) (hash.Hash, error) {
+			return newChecksum(), nil
+		},
+		security: SecurityStatusChecksum,
+	}
+}
+
+// registerSeededChecksum registers a non-cryptographic checksum that can be used with a seed.
+func registerSeededChecksum(name string, newChecksum func(seed uint64) (hash.Hash, error)) {
+	hashAlgorithmNameToAlgorithm[name] = &algorithm{
+		create: func(p *Parameters) (hash.Hash, error) {
+			return newChecksum(p.Seed)
+		},
+		usage:    usesSeed,
+		security: SecurityStatusChecksum,
+	}
+}
+
+// registerCRC registers a CRC of the catalogue under the lower case name of its model.
+func registerCRC(model *crc.Model) {
+	hashAlgorithmNameToAlgorithm[strings.ToLower(model.Name)] = newCRCAlgorithm(model)
+}
+
+// registerKeyedHash registers a hash function with a fixed output length that can only be used with a key.
+// The key size is the smallest valid key size.
+func registerKeyedHash(name string, newKeyedHash func(key []byte) (hash.Hash, error), keySize int) {
+	registerKeyedAlgorithm(
+		name,
+		func(p *Parameters) (hash.Hash, error) {
+			return newKeyedHash(p.Key)
+		},
+		0,
+		keySize,
+	)
+}
+
+// registerKeyedAlgorithm registers a hash function that can only be used with a key and may use more parameters.
+// The key size is the smallest valid key size.
+func registerKeyedAlgorithm(name string, create func(p *Parameters) (hash.Hash, error), additionalUsage parameterUsage, keySize int) {
+	hashAlgorithmNameToAlgorithm[name] = &algorithm{
+		create:   create,
+		usage:    usesKey | needsKey | additionalUsage,
+		security: SecurityStatusSecure,
+		keySize:  keySize,
+	}
+}
+
+// registerBlake2 registers a BLAKE2 function under its name, which contains the digest size in bits.
+func registerBlake2(name string) {
+	hashAlgorithmNameToAlgorithm[name], _ = newBlake2Algorithm(name)
+}
+
+// registerXOF registers an extendable-output function.
+func registerXOF(name string, newShake func() sha3.ShakeHash) {
+	hashAlgorithmNameToAlgorithm[name] = &algorithm{
+		create: func(p *Parameters) (hash.Hash, error) {
+			return newXOFHash(newShake(), p.OutputLength), nil
+		},
+		usage:    usesOutputLength,
+		security: SecurityStatusSecure,
+	}
+}
+
+// registerCustomizable registers a hash function that gets all its parameters on creation.
+func registerCustomizable(name string, newCustomizable func(p *Parameters) hash.Hash, usage parameterUsage) {
+	registerAlgorithm(
+		name,
+		func(p *Parameters) (hash.Hash, error) {
+			return newCustomizable(p), nil
+		},
+		usage,
+	)
+}
+
+// registerAlgorithm registers a hash function with a creation function that may return an error.
+func registerAlgorithm(name string, create func(p *Parameters) (hash.Hash, error), usage parameterUsage) {
+	hashAlgorithmNameToAlgorithm[name] = &algorithm{
+		create:   create,
+		usage:    usage,
+		security: SecurityStatusSecure,
+	}
+}
+
+// -------- Creation functions --------
+
+// lookup returns the algorithm for a hash algorithm name, an alias or an object identifier.
+// BLAKE2 functions with digest sizes that are not registered are created on demand.
+func lookup(hashAlgorithm string) (*algorithm, bool) {
+	name := canonicalName(hashAlgorithm)
+
+	a, ok := hashAlgorithmNameToAlgorithm[name]
+	if ok {
+		return a, true
+	}
+
+	return newBlake2Algorithm(name)
+}
+
+// newBlake2Algorithm creates the algorithm for a BLAKE2 name of the form 'blake2b-<bits>' or 'blake2s-<bits>'.
+// It returns false, if the name is not a valid BLAKE2 name.
+func newBlake2Algorithm(name string) (*algorithm, bool) {
+	var newBlake2 func(p *blake2.Parameters) (hash.Hash, error)
+	var maxSize int
+
+	sizeText, isB := strings.CutPrefix(name, `blake2b-`)
+	if isB {
+		newBlake2 = newBlake2b
+		maxSize = blake2.MaxSizeB
+	} else {
+		var isS bool
+		sizeText, isS = strings.CutPrefix(name, `blake2s-`)
+		if !isS {
+			return nil, false
+		}
+
+		newBlake2 = newBlake2s
+		maxSize = blake2.MaxSizeS
+	}
+
+	// Only the canonical decimal representation of a multiple of 8 is a valid size.
+	bitSize, err := strconv.Atoi(sizeText)
+	if err != nil || strconv.Itoa(bitSize) != sizeText || bitSize%8 != 0 || bitSize < 8 || bitSize > maxSize<<3 {
+		return nil, false
+	}
+
+	return &algorithm{
+		create: func(p *Parameters) (hash.Hash, error) {
+			return newBlake2(&blake2.Parameters{
+				Size:            bitSize >> 3,
+				Key:             p.Key,
+				Salt:            p.Salt,
+				Personalization: p.Personalization,
+			})
+		},
+		usage:    usesKey | usesSalt | usesPersonalization,
+		security: SecurityStatusSecure,
+	}, true
+}
+
+// newBlake2b creates a BLAKE2b function.
+func newBlake2b(p *blake2.Parameters) (hash.Hash, error) {
+	h, err := blake2.NewB(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// newBlake2s creates a BLAKE2s function.
+func newBlake2s(p *blake2.Parameters) (hash.Hash, error) {
+	h, err := blake2.NewS(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// newBlake3 creates a BLAKE3 hash function.
+// The mode is selected by the parameters: A key selects the keyed hash mode and
+// a context string selects the key derivation mode.
+func newBlake3(p *Parameters) (hash.Hash, error) {
+	hasKey := len(p.Key) != 0
+	hasContext := len(p.Context) != 0
+
+	switch {
+	case hasKey && hasContext:
+		return nil, errKeyAndContext
+
+	case hasKey:
+		h, err := blake3.NewKeyed(p.Key, p.OutputLength)
+		if err != nil {
+			return nil, err
+		}
+
+		return h, nil
+
+	case hasContext:
+		return blake3.NewDeriveKey(p.Context, p.OutputLength), nil
+
+	default:
+		return blake3.New(p.OutputLength), nil
+	}
+}
+
+// newTurboSHAKE128 creates a TurboSHAKE128 function.
+func newTurboSHAKE128(p *Parameters) hash.Hash {
+	return k12.NewTurboSHAKE128(p.OutputLength)
+}
+
+// newTurboSHAKE256 creates a TurboSHAKE256 function.
+func newTurboSHAKE256(p *Parameters) hash.Hash {
+	return k12.NewTurboSHAKE256(p.OutputLength)
+}
+
+// newK12 creates a KangarooTwelve function.
+func newK12(p *Parameters) hash.Hash {
+	return k12.New(p.Customization, p.OutputLength)
+}
+
+// newAsconXOF128 creates an Ascon-XOF128 function.
+func newAsconXOF128(p *Parameters) hash.Hash {
+	return ascon.NewXOF128(p.OutputLength)
+}
+
+// newAsconCXOF128 creates an Ascon-CXOF128 function.
+func newAsconCXOF128(p *Parameters) (hash.Hash, error) {
+	h, err := ascon.NewCXOF128(p.Customization, p.OutputLength)
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// newSkein256 creates a Skein-256 function. A key selects the MAC mode.
+func newSkein256(p *Parameters) hash.Hash {
+	return skein.New256(p.Key, p.OutputLength)
+}
+
+// newSkein512 creates a Skein-512 function. A key selects the MAC mode.
+func newSkein512(p *Parameters) hash.Hash {
+	return skein.New512(p.Key, p.OutputLength)
+}
+
+// newSkein1024 creates a Skein-1024 function. A key selects the MAC mode.
+func newSkein1024(p *Parameters) hash.Hash {
+	return skein.New1024(p.Key, p.OutputLength)
+}
+
+// newSipHash13 creates a SipHash-1-3 function.
+func newSipHash13(key []byte) (hash.Hash, error) {
+	h, err := siphash.New13(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// newSipHash24 creates a SipHash-2-4 function.
+func newSipHash24(key []byte) (hash.Hash, error) {
+	h, err := siphash.New24(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// newAESCMAC creates an AES-CMAC function.
+func newAESCMAC(key []byte) (hash.Hash, error) {
+	h, err := mac.NewCMAC(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// newAESGMAC creates an AES-GMAC function.
+func newAESGMAC(p *Parameters) (hash.Hash, error) {
+	h, err := mac.NewGMAC(p.Key, p.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// newPoly1305 creates a Poly1305 function.
+func newPoly1305(key []byte) (hash.Hash, error) {
+	h, err := mac.NewPoly1305(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// newCRCAlgorithm creates the algorithm for a CRC model.
+func newCRCAlgorithm(model *crc.Model) *algorithm {
+	return &algorithm{
+		create: func(_ *Parameters) (hash.Hash, error) {
+			h, err := crc.New(model)
+			if err != nil {
+				return nil, err
+			}
+
+			return h, nil
+		},
+		security: SecurityStatusChecksum,
+	}
+}
+
+// -------- Algorithm methods --------
+
+// checkParameters checks whether the parameters are valid for the algorithm.
+func (a *algorithm) checkParameters(p *Parameters) error {
+	hasKey := len(p.Key) != 0
+
+	if hasKey && a.usage&(usesHMAC|usesKey) == 0 {
+		return errKeyNotSupported
+	}
+
+	if !hasKey && a.usage&needsKey != 0 {
+		return errKeyRequired
+	}
+
+	if p.OutputLength != 0 && a.usage&usesOutputLength == 0 {
+		return errOutputLengthNotSupported
+	}
+
+	if p.OutputLength < 0 {
+		return errOutputLengthNegative
+	}
+
+	if len(p.Customization) != 0 && a.usage&usesCustomization == 0 {
+		return errCustomizationNotSupported
+	}
+
+	if len(p.FunctionName) != 0 && a.usage&usesFunctionName == 0 {
+		return errFunctionNameNotSupported
+	}
+
+	if p.BlockSize != 0 && a.usage&usesBlockSize == 0 {
+		return errBlockSizeNotSupported
+	}
+
+	if p.BlockSize < 0 {
+		return errBlockSizeNegative
+	}
+
+	if p.BlockSize > MaxBlockSize {
+		return errBlockSizeTooLarge
+	}
+
+	if len(p.Context) != 0 && a.usage&usesContext == 0 {
+		return errContextNotSupported
+	}
+
+	if p.Seed != 0 && a.usage&usesSeed == 0 {
+		return errSeedNotSupported
+	}
+
+	if len(p.Salt) != 0 && a.usage&usesSalt == 0 {
+		return errSaltNotSupported
+	}
+
+	if len(p.Personalization) != 0 && a.usage&usesPersonalization == 0 {
+		return errPersonalizationNotSupported
+	}
+
+	hasNonce := len(p.Nonce) != 0
+
+	if hasNonce && a.usage&usesNonce == 0 {
+		return errNonceNotSupported
+	}
+
+	if !hasNonce && a.usage&needsNonce != 0 {
+		return errNonceRequired
+	}
+
+	return nil
 }
 
-// newBlake2s_256 creates a Blake2s-256 hash function.
-func newBlake2s_256() hash.Hash {
-	hashFunc, _ := blake2s.New256(nil)
+// newUnkeyed creates the unkeyed hash function of the algorithm.
+// It is used as the hash function of an HMAC.
+func (a *algorithm) newUnkeyed() hash.Hash {
+	// The only errors that can be returned are parameter errors, which can not
+	// happen here, as there are no parameters.
+	hashFunc, _ := a.create(noParameters)
 	return hashFunc
 }
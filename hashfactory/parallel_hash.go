@@ -0,0 +1,220 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package hashfactory
+
+import (
+	"golang.org/x/crypto/sha3"
+	"hash"
+	"runtime"
+	"sync"
+)
+
+// ******** Private constants ********
+
+// defaultParallelHashBlockSize is the block size of the ParallelHash functions,
+// if no block size is specified.
+const defaultParallelHashBlockSize = 8192
+
+// minParallelHashBatchSize is the minimum number of bytes that are collected before
+// the blocks are hashed in parallel.
+const minParallelHashBatchSize = 1024 * 1024
+
+// maxParallelHashBatchSize is the maximum number of bytes that are collected before
+// the blocks are hashed in parallel.
+const maxParallelHashBatchSize = 64 * 1024 * 1024
+
+// ******** Private types ********
+
+// parallelHash implements the ParallelHash functions from NIST SP 800-185.
+// The input is split into blocks which are hashed in parallel.
+type parallelHash struct {
+	newCShake     newCShakeFunction
+	customization []byte
+	outer         sha3.ShakeHash
+	blockSize     int
+	leafLength    int
+	batchSize     int
+	buffer        []byte
+	blockCount    uint64
+	outputLength  int
+	isXOF         bool
+}
+
+// ******** Private functions ********
+
+// -------- Creation functions --------
+
+// newParallelHash128 creates a new ParallelHash128 function.
+func newParallelHash128(p *Parameters) hash.Hash {
+	return newParallelHash(sha3.NewCShake128, p, 32, false)
+}
+
+// newParallelHash256 creates a new ParallelHash256 function.
+func newParallelHash256(p *Parameters) hash.Hash {
+	return newParallelHash(sha3.NewCShake256, p, 64, false)
+}
+
+// newParallelHashXOF128 creates a new ParallelHashXOF128 function.
+func newParallelHashXOF128(p *Parameters) hash.Hash {
+	return newParallelHash(sha3.NewCShake128, p, 32, true)
+}
+
+// newParallelHashXOF256 creates a new ParallelHashXOF256 function.
+func newParallelHashXOF256(p *Parameters) hash.Hash {
+	return newParallelHash(sha3.NewCShake256, p, 64, true)
+}
+
+// newParallelHash creates a new ParallelHash function with the supplied cSHAKE function.
+// The leaf hashes have the same length as the default output length.
+func newParallelHash(
+	newCShake newCShakeFunction,
+	p *Parameters,
+	defaultOutputLength int,
+	isXOF bool,
+) *parallelHash {
+	blockSize := p.BlockSize
+	if blockSize == 0 {
+		blockSize = defaultParallelHashBlockSize
+	}
+
+	outputLength := p.OutputLength
+	if outputLength == 0 {
+		outputLength = defaultOutputLength
+	}
+
+	// Collect enough blocks to keep all processors busy, but do not use too much memory.
+	batchBlocks := max(runtime.NumCPU(), minParallelHashBatchSize/blockSize)
+	batchBlocks = max(1, min(batchBlocks, maxParallelHashBatchSize/blockSize))
+
+	result := &parallelHash{
+		newCShake:     newCShake,
+		customization: p.Customization,
+		blockSize:     blockSize,
+		leafLength:    defaultOutputLength,
+		batchSize:     batchBlocks * blockSize,
+		outputLength:  outputLength,
+		isXOF:         isXOF,
+	}
+
+	result.Reset()
+
+	return result
+}
+
+// -------- parallelHash methods --------
+
+// Write adds more data to the running hash.
+func (h *parallelHash) Write(p []byte) (int, error) {
+	n := len(p)
+
+	for len(p) > 0 {
+		chunkLength := min(len(p), h.batchSize-len(h.buffer))
+		h.buffer = append(h.buffer, p[:chunkLength]...)
+		p = p[chunkLength:]
+
+		if len(h.buffer) == h.batchSize {
+			h.blockCount += h.hashBlocks(h.outer, h.buffer)
+			h.buffer = h.buffer[:0]
+		}
+	}
+
+	return n, nil
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (h *parallelHash) Sum(b []byte) []byte {
+	result, out := sliceForAppend(b, h.outputLength)
+
+	final := h.outer.Clone()
+	blockCount := h.blockCount + h.hashBlocks(final, h.buffer)
+	_, _ = final.Write(rightEncode(blockCount))
+	_, _ = final.Write(encodeOutputLength(h.outputLength, h.isXOF))
+	_, _ = final.Read(out)
+
+	return result
+}
+
+// Reset resets the hash to its initial state.
+func (h *parallelHash) Reset() {
+	h.outer = h.newCShake([]byte(parallelHashFunctionName), h.customization)
+	_, _ = h.outer.Write(leftEncode(uint64(h.blockSize)))
+
+	h.buffer = make([]byte, 0, h.batchSize)
+	h.blockCount = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (h *parallelHash) Size() int {
+	return h.outputLength
+}
+
+// BlockSize returns the hash's underlying block size.
+func (h *parallelHash) BlockSize() int {
+	return h.outer.BlockSize()
+}
+
+// hashBlocks hashes the blocks in data in parallel and writes the leaf hashes to outer.
+// The last block may be shorter than the block size.
+// It returns the number of blocks.
+func (h *parallelHash) hashBlocks(outer sha3.ShakeHash, data []byte) uint64 {
+	blockCount := (len(data) + h.blockSize - 1) / h.blockSize
+	if blockCount == 0 {
+		return 0
+	}
+
+	leaves := make([]byte, blockCount*h.leafLength)
+
+	workerCount := min(runtime.NumCPU(), blockCount)
+	blocksPerWorker := (blockCount + workerCount - 1) / workerCount
+
+	var wg sync.WaitGroup
+	for first := 0; first < blockCount; first += blocksPerWorker {
+		last := min(first+blocksPerWorker, blockCount)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			// A cSHAKE function without function name and customization is a SHAKE function.
+			leaf := h.newCShake(nil, nil)
+			for i := first; i < last; i++ {
+				leaf.Reset()
+				_, _ = leaf.Write(data[i*h.blockSize : min((i+1)*h.blockSize, len(data))])
+				_, _ = leaf.Read(leaves[i*h.leafLength : (i+1)*h.leafLength])
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	_, _ = outer.Write(leaves)
+
+	return uint64(blockCount)
+}
@@ -0,0 +1,277 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.1.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//    2026-10-16: V1.1.0: Add TupleHash functions.
+//
+
+package hashfactory
+
+import (
+	"encoding/binary"
+	"golang.org/x/crypto/sha3"
+	"hash"
+	"math/bits"
+)
+
+// This file contains the functions that are derived from SHA-3 as specified in
+// NIST SP 800-185 (https://doi.org/10.6028/NIST.SP.800-185).
+
+// ******** Public types ********
+
+// TupleHash is implemented by hash functions that hash a tuple of byte strings.
+type TupleHash interface {
+	hash.Hash
+
+	// StartElement starts a new element of the tuple with the given length in bytes.
+	// Exactly this number of bytes has to be written before the next element is started.
+	StartElement(length uint64)
+}
+
+// ******** Private constants ********
+
+// Rates of the cSHAKE functions in bytes.
+const (
+	rateCShake128 = 168
+	rateCShake256 = 136
+)
+
+// Function names of the functions that are derived from cSHAKE.
+const (
+	kmacFunctionName         = `KMAC`
+	tupleHashFunctionName    = `TupleHash`
+	parallelHashFunctionName = `ParallelHash`
+)
+
+// ******** Private types ********
+
+// newCShakeFunction is the type of the cSHAKE creation functions.
+type newCShakeFunction func(functionName []byte, customization []byte) sha3.ShakeHash
+
+// sp800185Hash is a cSHAKE function that has the encoded output length appended to its input.
+// This is the common part of the KMAC and TupleHash functions.
+type sp800185Hash struct {
+	cshake       sha3.ShakeHash
+	initialState sha3.ShakeHash
+	outputLength int
+	isXOF        bool
+}
+
+// tupleHash implements the TupleHash functions.
+type tupleHash struct {
+	sp800185Hash
+}
+
+// ******** Private functions ********
+
+// -------- Creation functions --------
+
+// newCShake128 creates a new cSHAKE128 function.
+func newCShake128(p *Parameters) hash.Hash {
+	return newXOFHash(sha3.NewCShake128(p.FunctionName, p.Customization), p.OutputLength)
+}
+
+// newCShake256 creates a new cSHAKE256 function.
+func newCShake256(p *Parameters) hash.Hash {
+	return newXOFHash(sha3.NewCShake256(p.FunctionName, p.Customization), p.OutputLength)
+}
+
+// newKMAC128 creates a new KMAC128 function.
+func newKMAC128(p *Parameters) hash.Hash {
+	return newKMAC(sha3.NewCShake128, rateCShake128, p, 32, false)
+}
+
+// newKMAC256 creates a new KMAC256 function.
+func newKMAC256(p *Parameters) hash.Hash {
+	return newKMAC(sha3.NewCShake256, rateCShake256, p, 64, false)
+}
+
+// newKMACXOF128 creates a new KMACXOF128 function.
+func newKMACXOF128(p *Parameters) hash.Hash {
+	return newKMAC(sha3.NewCShake128, rateCShake128, p, 32, true)
+}
+
+// newKMACXOF256 creates a new KMACXOF256 function.
+func newKMACXOF256(p *Parameters) hash.Hash {
+	return newKMAC(sha3.NewCShake256, rateCShake256, p, 64, true)
+}
+
+// newTupleHash128 creates a new TupleHash128 function.
+func newTupleHash128(p *Parameters) hash.Hash {
+	return newTupleHash(sha3.NewCShake128, p, 32, false)
+}
+
+// newTupleHash256 creates a new TupleHash256 function.
+func newTupleHash256(p *Parameters) hash.Hash {
+	return newTupleHash(sha3.NewCShake256, p, 64, false)
+}
+
+// newTupleHashXOF128 creates a new TupleHashXOF128 function.
+func newTupleHashXOF128(p *Parameters) hash.Hash {
+	return newTupleHash(sha3.NewCShake128, p, 32, true)
+}
+
+// newTupleHashXOF256 creates a new TupleHashXOF256 function.
+func newTupleHashXOF256(p *Parameters) hash.Hash {
+	return newTupleHash(sha3.NewCShake256, p, 64, true)
+}
+
+// newKMAC creates a new KMAC function with the supplied cSHAKE function.
+func newKMAC(
+	newCShake newCShakeFunction,
+	rate int,
+	p *Parameters,
+	defaultOutputLength int,
+	isXOF bool,
+) *sp800185Hash {
+	cshake := newCShake([]byte(kmacFunctionName), p.Customization)
+	_, _ = cshake.Write(bytePad(encodeString(p.Key), rate))
+
+	return newSP800185Hash(cshake, p.OutputLength, defaultOutputLength, isXOF)
+}
+
+// newTupleHash creates a new TupleHash function with the supplied cSHAKE function.
+func newTupleHash(
+	newCShake newCShakeFunction,
+	p *Parameters,
+	defaultOutputLength int,
+	isXOF bool,
+) *tupleHash {
+	cshake := newCShake([]byte(tupleHashFunctionName), p.Customization)
+
+	return &tupleHash{*newSP800185Hash(cshake, p.OutputLength, defaultOutputLength, isXOF)}
+}
+
+// newSP800185Hash creates a new sp800185Hash from a cSHAKE function that has already
+// absorbed the prefix of the function.
+func newSP800185Hash(cshake sha3.ShakeHash, outputLength int, defaultOutputLength int, isXOF bool) *sp800185Hash {
+	if outputLength == 0 {
+		outputLength = defaultOutputLength
+	}
+
+	return &sp800185Hash{
+		cshake:       cshake,
+		initialState: cshake.Clone(),
+		outputLength: outputLength,
+		isXOF:        isXOF,
+	}
+}
+
+// -------- sp800185Hash methods --------
+
+// Write adds more data to the running hash.
+func (h *sp800185Hash) Write(p []byte) (int, error) {
+	return h.cshake.Write(p)
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (h *sp800185Hash) Sum(b []byte) []byte {
+	result, out := sliceForAppend(b, h.outputLength)
+
+	final := h.cshake.Clone()
+	_, _ = final.Write(encodeOutputLength(h.outputLength, h.isXOF))
+	_, _ = final.Read(out)
+
+	return result
+}
+
+// Reset resets the hash to its initial state.
+func (h *sp800185Hash) Reset() {
+	h.cshake = h.initialState.Clone()
+}
+
+// Size returns the number of bytes Sum will return.
+func (h *sp800185Hash) Size() int {
+	return h.outputLength
+}
+
+// BlockSize returns the hash's underlying block size.
+func (h *sp800185Hash) BlockSize() int {
+	return h.cshake.BlockSize()
+}
+
+// -------- tupleHash methods --------
+
+// StartElement starts a new element of the tuple with the given length in bytes.
+func (h *tupleHash) StartElement(length uint64) {
+	_, _ = h.cshake.Write(leftEncode(length << 3))
+}
+
+// -------- Encoding functions --------
+
+// leftEncode encodes x as a byte string with the length of the byte string in front of it.
+func leftEncode(x uint64) []byte {
+	n := byteLength(x)
+
+	result := make([]byte, 9)
+	binary.BigEndian.PutUint64(result[1:], x)
+	result = result[8-n:]
+	result[0] = byte(n)
+
+	return result
+}
+
+// rightEncode encodes x as a byte string with the length of the byte string behind it.
+func rightEncode(x uint64) []byte {
+	n := byteLength(x)
+
+	result := make([]byte, 9)
+	binary.BigEndian.PutUint64(result, x)
+	result = result[8-n:]
+	result[n] = byte(n)
+
+	return result
+}
+
+// encodeString encodes a byte string with its bit length in front of it.
+func encodeString(s []byte) []byte {
+	return append(leftEncode(uint64(len(s))<<3), s...)
+}
+
+// bytePad prepends the encoded rate to x and pads the result with zeros to a multiple of the rate.
+func bytePad(x []byte, rate int) []byte {
+	result := leftEncode(uint64(rate))
+	result = append(result, x...)
+
+	padLength := (rate - len(result)%rate) % rate
+
+	return append(result, make([]byte, padLength)...)
+}
+
+// encodeOutputLength encodes the output length in bits that is appended to the input.
+// The XOF variants encode an output length of 0.
+func encodeOutputLength(outputLength int, isXOF bool) []byte {
+	if isXOF {
+		return rightEncode(0)
+	}
+
+	return rightEncode(uint64(outputLength) << 3)
+}
+
+// byteLength returns the number of bytes needed to encode x. This is at least 1.
+func byteLength(x uint64) int {
+	return max(1, (bits.Len64(x)+7)>>3)
+}
@@ -0,0 +1,344 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package hashfactory
+
+import (
+	"encoding/hex"
+	"errors"
+	"golang.org/x/crypto/sha3"
+	"testing"
+)
+
+// ******** Private types ********
+
+// tupleHashVector is a TupleHash test vector.
+type tupleHashVector struct {
+	algorithm     string
+	customization string
+	elements      []string
+	expected      string
+}
+
+// parallelHashVector is a ParallelHash test vector.
+type parallelHashVector struct {
+	algorithm     string
+	customization string
+	blockSize     int
+	input         string
+	expected      string
+}
+
+// ******** Private variables ********
+
+// The test vectors are the sample values from
+// https://csrc.nist.gov/projects/cryptographic-standards-and-guidelines/example-values.
+
+// tupleHashVectors contains the TupleHash test vectors.
+var tupleHashVectors = []tupleHashVector{
+	{
+		algorithm:     `tuplehash128`,
+		customization: ``,
+		elements:      []string{`000102`, `101112131415`},
+		expected:      `c5d8786c1afb9b82111ab34b65b2c0048fa64e6d48e263264ce1707d3ffc8ed1`,
+	},
+	{
+		algorithm:     `tuplehash128`,
+		customization: `My Tuple App`,
+		elements:      []string{`000102`, `101112131415`},
+		expected:      `75cdb20ff4db1154e841d758e24160c54bae86eb8c13e7f5f40eb35588e96dfb`,
+	},
+	{
+		algorithm:     `tuplehash128`,
+		customization: `My Tuple App`,
+		elements:      []string{`000102`, `101112131415`, `202122232425262728`},
+		expected:      `e60f202c89a2631eda8d4c588ca5fd07f39e5151998deccf973adb3804bb6e84`,
+	},
+	{
+		algorithm:     `tuplehash256`,
+		customization: ``,
+		elements:      []string{`000102`, `101112131415`},
+		expected:      `cfb7058caca5e668f81a12a20a2195ce97a925f1dba3e7449a56f82201ec607311ac2696b1ab5ea2352df1423bde7bd4bb78c9aed1a853c78672f9eb23bbe194`,
+	},
+	{
+		algorithm:     `tuplehash256`,
+		customization: `My Tuple App`,
+		elements:      []string{`000102`, `101112131415`},
+		expected:      `147c2191d5ed7efd98dbd96d7ab5a11692576f5fe2a5065f3e33de6bba9f3aa1c4e9a068a289c61c95aab30aee1e410b0b607de3620e24a4e3bf9852a1d4367e`,
+	},
+	{
+		algorithm:     `tuplehash256`,
+		customization: `My Tuple App`,
+		elements:      []string{`000102`, `101112131415`, `202122232425262728`},
+		expected:      `45000be63f9b6bfd89f54717670f69a9bc763591a4f05c50d68891a744bcc6e7d6d5b5e82c018da999ed35b0bb49c9678e526abd8e85c13ed254021db9e790ce`,
+	},
+	{
+		algorithm:     `tuplehashxof128`,
+		customization: ``,
+		elements:      []string{`000102`, `101112131415`},
+		expected:      `2f103cd7c32320353495c68de1a8129245c6325f6f2a3d608d92179c96e68488`,
+	},
+	{
+		algorithm:     `tuplehashxof128`,
+		customization: `My Tuple App`,
+		elements:      []string{`000102`, `101112131415`},
+		expected:      `3fc8ad69453128292859a18b6c67d7ad85f01b32815e22ce839c49ec374e9b9a`,
+	},
+	{
+		algorithm:     `tuplehashxof128`,
+		customization: `My Tuple App`,
+		elements:      []string{`000102`, `101112131415`, `202122232425262728`},
+		expected:      `900fe16cad098d28e74d632ed852f99daab7f7df4d99e775657885b4bf76d6f8`,
+	},
+	{
+		algorithm:     `tuplehashxof256`,
+		customization: ``,
+		elements:      []string{`000102`, `101112131415`},
+		expected:      `03ded4610ed6450a1e3f8bc44951d14fbc384ab0efe57b000df6b6df5aae7cd568e77377daf13f37ec75cf5fc598b6841d51dd207c991cd45d210ba60ac52eb9`,
+	},
+	{
+		algorithm:     `tuplehashxof256`,
+		customization: `My Tuple App`,
+		elements:      []string{`000102`, `101112131415`},
+		expected:      `6483cb3c9952eb20e830af4785851fc597ee3bf93bb7602c0ef6a65d741aeca7e63c3b128981aa05c6d27438c79d2754bb1b7191f125d6620fca12ce658b2442`,
+	},
+	{
+		algorithm:     `tuplehashxof256`,
+		customization: `My Tuple App`,
+		elements:      []string{`000102`, `101112131415`, `202122232425262728`},
+		expected:      `0c59b11464f2336c34663ed51b2b950bec743610856f36c28d1d088d8a2446284dd09830a6a178dc752376199fae935d86cfdee5913d4922dfd369b66a53c897`,
+	},
+}
+
+// parallelHashVectors contains the ParallelHash test vectors.
+var parallelHashVectors = []parallelHashVector{
+	{
+		algorithm:     `parallelhash128`,
+		customization: ``,
+		blockSize:     8,
+		input:         `000102030405060710111213141516172021222324252627`,
+		expected:      `ba8dc1d1d979331d3f813603c67f72609ab5e44b94a0b8f9af46514454a2b4f5`,
+	},
+	{
+		algorithm:     `parallelhash128`,
+		customization: `Parallel Data`,
+		blockSize:     8,
+		input:         `000102030405060710111213141516172021222324252627`,
+		expected:      `fc484dcb3f84dceedc353438151bee58157d6efed0445a81f165e495795b7206`,
+	},
+	{
+		algorithm:     `parallelhash256`,
+		customization: ``,
+		blockSize:     8,
+		input:         `000102030405060710111213141516172021222324252627`,
+		expected:      `bc1ef124da34495e948ead207dd9842235da432d2bbc54b4c110e64c451105531b7f2a3e0ce055c02805e7c2de1fb746af97a1dd01f43b824e31b87612410429`,
+	},
+	{
+		algorithm:     `parallelhash256`,
+		customization: `Parallel Data`,
+		blockSize:     8,
+		input:         `000102030405060710111213141516172021222324252627`,
+		expected:      `cdf15289b54f6212b4bc270528b49526006dd9b54e2b6add1ef6900dda3963bb33a72491f236969ca8afaea29c682d47a393c065b38e29fae651a2091c833110`,
+	},
+	{
+		algorithm:     `parallelhashxof128`,
+		customization: ``,
+		blockSize:     8,
+		input:         `000102030405060710111213141516172021222324252627`,
+		expected:      `fe47d661e49ffe5b7d999922c062356750caf552985b8e8ce6667f2727c3c8d3`,
+	},
+	{
+		algorithm:     `parallelhashxof128`,
+		customization: `Parallel Data`,
+		blockSize:     8,
+		input:         `000102030405060710111213141516172021222324252627`,
+		expected:      `ea2a793140820f7a128b8eb70a9439f93257c6e6e79b4a540d291d6dae7098d7`,
+	},
+	{
+		algorithm:     `parallelhashxof256`,
+		customization: ``,
+		blockSize:     8,
+		input:         `000102030405060710111213141516172021222324252627`,
+		expected:      `c10a052722614684144d28474850b410757e3cba87651ba167a5cbddff7f466675fbf84bcae7378ac444be681d729499afca667fb879348bfdda427863c82f1c`,
+	},
+	{
+		algorithm:     `parallelhashxof256`,
+		customization: `Parallel Data`,
+		blockSize:     8,
+		input:         `000102030405060710111213141516172021222324252627`,
+		expected:      `538e105f1a22f44ed2f5cc1674fbd40be803d9c99bf5f8d90a2c8193f3fe6ea768e5c1a20987e2c9c65febed03887a51d35624ed12377594b5585541dc377efc`,
+	},
+}
+
+// ******** Test functions ********
+
+// TestTupleHash tests the TupleHash functions with the NIST sample values.
+func TestTupleHash(t *testing.T) {
+	for _, v := range tupleHashVectors {
+		h, err := NewWithParameters(v.algorithm, &Parameters{Customization: []byte(v.customization)})
+		if err != nil {
+			t.Fatalf(`%s: unexpected error: %v`, v.algorithm, err)
+		}
+
+		th := h.(TupleHash)
+		for _, e := range v.elements {
+			element := mustDecodeHex(t, e)
+			th.StartElement(uint64(len(element)))
+			_, _ = th.Write(element)
+		}
+
+		result := hex.EncodeToString(th.Sum(nil))
+		if result != v.expected {
+			t.Errorf(`%s "%s" with %d elements: got %s, expected %s`, v.algorithm, v.customization, len(v.elements), result, v.expected)
+		}
+	}
+}
+
+// TestParallelHash tests the ParallelHash functions with the NIST sample values.
+func TestParallelHash(t *testing.T) {
+	for _, v := range parallelHashVectors {
+		h, err := NewWithParameters(v.algorithm, &Parameters{Customization: []byte(v.customization), BlockSize: v.blockSize})
+		if err != nil {
+			t.Fatalf(`%s: unexpected error: %v`, v.algorithm, err)
+		}
+
+		input := mustDecodeHex(t, v.input)
+
+		_, _ = h.Write(input)
+		result := hex.EncodeToString(h.Sum(nil))
+		if result != v.expected {
+			t.Errorf(`%s "%s": got %s, expected %s`, v.algorithm, v.customization, result, v.expected)
+		}
+
+		h.Reset()
+		for i := range input {
+			_, _ = h.Write(input[i : i+1])
+		}
+
+		result = hex.EncodeToString(h.Sum(nil))
+		if result != v.expected {
+			t.Errorf(`%s "%s" byte by byte: got %s, expected %s`, v.algorithm, v.customization, result, v.expected)
+		}
+	}
+}
+
+// TestParallelHashLargeInput tests ParallelHash with an input that spans several batches
+// against a straightforward sequential implementation.
+func TestParallelHashLargeInput(t *testing.T) {
+	input := make([]byte, 3*minParallelHashBatchSize+12345)
+	for i := range input {
+		input[i] = byte(i % 251)
+	}
+
+	expected := hex.EncodeToString(sequentialParallelHash128(input, defaultParallelHashBlockSize))
+
+	h, err := NewWithParameters(`parallelhash128`, &Parameters{})
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	_, _ = h.Write(input)
+	result := hex.EncodeToString(h.Sum(nil))
+	if result != expected {
+		t.Errorf(`Written at once: got %s, expected %s`, result, expected)
+	}
+
+	h.Reset()
+	for len(input) > 0 {
+		n := min(len(input), 100_003)
+		_, _ = h.Write(input[:n])
+		input = input[n:]
+	}
+
+	result = hex.EncodeToString(h.Sum(nil))
+	if result != expected {
+		t.Errorf(`Written in pieces: got %s, expected %s`, result, expected)
+	}
+}
+
+// TestBlockSizeLimits tests that invalid block sizes are rejected.
+func TestBlockSizeLimits(t *testing.T) {
+	_, err := NewWithParameters(`parallelhash128`, &Parameters{BlockSize: -1})
+	if !errors.Is(err, errBlockSizeNegative) {
+		t.Errorf(`Negative block size: got error %v, expected %v`, err, errBlockSizeNegative)
+	}
+
+	_, err = NewWithParameters(`parallelhash128`, &Parameters{BlockSize: MaxBlockSize + 1})
+	if !errors.Is(err, errBlockSizeTooLarge) {
+		t.Errorf(`Too large block size: got error %v, expected %v`, err, errBlockSizeTooLarge)
+	}
+
+	_, err = NewWithParameters(`parallelhash128`, &Parameters{BlockSize: MaxBlockSize})
+	if err != nil {
+		t.Errorf(`Maximum block size: unexpected error: %v`, err)
+	}
+
+	_, err = NewWithParameters(`sha3-256`, &Parameters{BlockSize: 8})
+	if !errors.Is(err, errBlockSizeNotSupported) {
+		t.Errorf(`Block size for sha3-256: got error %v, expected %v`, err, errBlockSizeNotSupported)
+	}
+}
+
+// ******** Private functions ********
+
+// mustDecodeHex decodes a hex string and fails the test if that is not possible.
+func mustDecodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+
+	result, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatalf(`Invalid hex string "%s": %v`, s, err)
+	}
+
+	return result
+}
+
+// sequentialParallelHash128 calculates ParallelHash128 with an empty customization string
+// and an output length of 32 bytes block by block, as specified in NIST SP 800-185.
+func sequentialParallelHash128(input []byte, blockSize int) []byte {
+	outer := sha3.NewCShake128([]byte(parallelHashFunctionName), nil)
+	_, _ = outer.Write(leftEncode(uint64(blockSize)))
+
+	var blockCount uint64
+	leafHash := make([]byte, 32)
+	for len(input) > 0 {
+		n := min(len(input), blockSize)
+		sha3.ShakeSum128(leafHash, input[:n])
+		_, _ = outer.Write(leafHash)
+		input = input[n:]
+		blockCount++
+	}
+
+	_, _ = outer.Write(rightEncode(blockCount))
+	_, _ = outer.Write(rightEncode(256))
+
+	result := make([]byte, 32)
+	_, _ = outer.Read(result)
+
+	return result
+}
@@ -0,0 +1,94 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package hashfactory
+
+import (
+	"golang.org/x/crypto/sha3"
+	"slices"
+)
+
+// xofHash wraps an extendable-output function so that it looks like a hash function
+// with a fixed output length.
+type xofHash struct {
+	xof          sha3.ShakeHash
+	outputLength int
+}
+
+// newXOFHash creates a new xofHash with the given output length.
+// If the output length is 0, the default size of the extendable-output function is used.
+func newXOFHash(xof sha3.ShakeHash, outputLength int) *xofHash {
+	if outputLength == 0 {
+		outputLength = xof.Size()
+	}
+
+	return &xofHash{
+		xof:          xof,
+		outputLength: outputLength,
+	}
+}
+
+// Write adds more data to the running hash.
+func (h *xofHash) Write(p []byte) (int, error) {
+	return h.xof.Write(p)
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (h *xofHash) Sum(b []byte) []byte {
+	result, out := sliceForAppend(b, h.outputLength)
+
+	// Reading from an extendable-output function changes its state. So read from a copy.
+	_, _ = h.xof.Clone().Read(out)
+
+	return result
+}
+
+// Reset resets the hash to its initial state.
+func (h *xofHash) Reset() {
+	h.xof.Reset()
+}
+
+// Size returns the number of bytes Sum will return.
+func (h *xofHash) Size() int {
+	return h.outputLength
+}
+
+// BlockSize returns the hash's underlying block size.
+func (h *xofHash) BlockSize() int {
+	return h.xof.BlockSize()
+}
+
+// sliceForAppend extends b by n bytes. It returns the extended slice
+// and the slice that contains the n appended bytes.
+func sliceForAppend(b []byte, n int) (result []byte, appended []byte) {
+	result = slices.Grow(b, n)[:len(b)+n]
+	appended = result[len(b):]
+
+	return
+}
@@ -0,0 +1,95 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package main
+
+import (
+	"golang.org/x/crypto/hkdf"
+	"hashvalue/encodedprinting"
+	"hashvalue/hashfactory"
+	"io"
+)
+
+// ******** Private constants ********
+
+// hkdfMaxBlocks is the maximum number of hash blocks that the expand step of HKDF can produce (RFC 5869).
+const hkdfMaxBlocks = 255
+
+// ******** Private functions ********
+
+// deriveHKDF derives a key with HKDF (RFC 5869) and prints it.
+func deriveHKDF(encodedPrinter encodedprinting.EncodedPrinter) int {
+	canonicalName, isKnown := hashfactory.CanonicalName(hkdfAlgorithm)
+	if !isKnown {
+		return printUsageErrorf(`Invalid hash algorithm: '%s'%s`, hkdfAlgorithm, didYouMean(hashfactory.Suggestions(hkdfAlgorithm)))
+	}
+
+	// Aliases are replaced by the name of the algorithm, so that all messages use that name.
+	hkdfAlgorithm = canonicalName
+
+	newHash, err := hashfactory.NewHMACHash(hkdfAlgorithm)
+	if err != nil {
+		return printUsageErrorf(`Hash algorithm '%s' can not be used for HKDF: %v`, hkdfAlgorithm, err)
+	}
+
+	// Warn, if a legacy hash algorithm is used.
+	if hashfactory.IsLegacy(hkdfAlgorithm) {
+		printWarningf(`'%s' is a legacy hash algorithm. It should only be used for compatibility with existing data.`, hkdfAlgorithm)
+	}
+
+	if extractOnly {
+		encodedPrinter.PrintEncoded(hkdf.Extract(newHash, ikmBytes, saltBytes))
+		return rcOK
+	}
+
+	// The input keying material is the pseudorandom key, if only the expand step is performed.
+	prk := ikmBytes
+	if !expandOnly {
+		prk = hkdf.Extract(newHash, ikmBytes, saltBytes)
+	}
+
+	size := newHash().Size()
+	length := outputLength
+	if length == 0 {
+		length = size
+	}
+
+	if length > hkdfMaxBlocks*size {
+		return printUsageErrorf(`Length must not be greater than %d bytes for HKDF with '%s'`, hkdfMaxBlocks*size, hkdfAlgorithm)
+	}
+
+	okm := make([]byte, length)
+	_, err = io.ReadFull(hkdf.Expand(newHash, prk, infoBytes), okm)
+	if err != nil {
+		return printErrorf(`Error deriving key: %v`, err)
+	}
+
+	encodedPrinter.PrintEncoded(okm)
+
+	return rcOK
+}
@@ -0,0 +1,240 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+// Package k12 implements the KangarooTwelve hash function and the TurboSHAKE extendable-output
+// functions as specified in RFC 9861.
+//
+// Both use the Keccak-p[1600] permutation with 12 rounds. KangarooTwelve supports a customization
+// string and arbitrary output lengths. Large inputs are hashed in parallel.
+package k12
+
+import (
+	"runtime"
+	"slices"
+	"sync"
+)
+
+// ******** Public constants ********
+
+// Size is the default size of a KangarooTwelve hash value in bytes.
+const Size = 32
+
+// ******** Private constants ********
+
+// chunkSize is the size of the chunks of the tree in bytes.
+const chunkSize = 8192
+
+// chainingValueSize is the size of the chaining value of a leaf in bytes.
+const chainingValueSize = 32
+
+// minBatchSize is the minimum number of bytes that are collected before the leaves are hashed in parallel.
+const minBatchSize = 1024 * 1024
+
+// These are the domain separation bytes of the nodes.
+const (
+	domainSingleNode = 0x07
+	domainFinalNode  = 0x06
+	domainLeaf       = 0x0b
+)
+
+// ******** Private variables ********
+
+// finalNodeMarker follows the first chunk in the final node, if there are more chunks.
+var finalNodeMarker = []byte{0x03, 0, 0, 0, 0, 0, 0, 0}
+
+// finalNodeTrailer is the end of the final node, if there are more chunks.
+var finalNodeTrailer = []byte{0xff, 0xff}
+
+// ******** Public types ********
+
+// Hasher implements the KangarooTwelve hash function.
+type Hasher struct {
+	customization []byte
+	final         sponge
+	totalLength   uint64
+	leaves        []byte
+	leafCount     uint64
+	batchSize     int
+	outputLength  int
+}
+
+// ******** Public functions ********
+
+// New creates a new KangarooTwelve hash function with the given customization string
+// and output length in bytes.
+// If the output length is 0, the default size is used.
+func New(customization []byte, outputLength int) *Hasher {
+	if outputLength == 0 {
+		outputLength = Size
+	}
+
+	// Collect enough chunks to keep all processors busy.
+	batchChunks := max(runtime.NumCPU(), minBatchSize/chunkSize)
+
+	return &Hasher{
+		customization: slices.Clone(customization),
+		final: sponge{
+			rate:   rate128,
+			rounds: turboSHAKERounds,
+		},
+		batchSize:    batchChunks * chunkSize,
+		outputLength: outputLength,
+	}
+}
+
+// -------- Hasher methods --------
+
+// Write adds more data to the running hash.
+func (h *Hasher) Write(p []byte) (int, error) {
+	n := len(p)
+
+	for len(p) > 0 {
+		// The first chunk is part of the final node.
+		if h.totalLength < chunkSize {
+			take := min(chunkSize-int(h.totalLength), len(p))
+			h.final.write(p[:take])
+			h.totalLength += uint64(take)
+			p = p[take:]
+
+			continue
+		}
+
+		if h.totalLength == chunkSize {
+			h.final.write(finalNodeMarker)
+		}
+
+		take := min(h.batchSize-len(h.leaves), len(p))
+		h.leaves = append(h.leaves, p[:take]...)
+		h.totalLength += uint64(take)
+		p = p[take:]
+
+		if len(h.leaves) == h.batchSize {
+			h.hashLeaves(h.leaves)
+			h.leaves = h.leaves[:0]
+		}
+	}
+
+	return n, nil
+}
+
+// Sum appends the current hash value to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (h *Hasher) Sum(b []byte) []byte {
+	// Work on a copy, so that the caller can keep writing.
+	c := *h
+	c.leaves = slices.Clone(h.leaves)
+
+	// The customization string and its length are appended to the message.
+	_, _ = c.Write(c.customization)
+	_, _ = c.Write(lengthEncode(uint64(len(c.customization))))
+
+	if c.totalLength <= chunkSize {
+		c.final.domain = domainSingleNode
+	} else {
+		c.hashLeaves(c.leaves)
+		c.final.write(lengthEncode(c.leafCount))
+		c.final.write(finalNodeTrailer)
+		c.final.domain = domainFinalNode
+	}
+
+	result := slices.Grow(b, c.outputLength)[:len(b)+c.outputLength]
+	c.final.read(result[len(b):])
+
+	return result
+}
+
+// Reset resets the hash to its initial state.
+func (h *Hasher) Reset() {
+	h.final.reset()
+	h.totalLength = 0
+	h.leaves = h.leaves[:0]
+	h.leafCount = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (h *Hasher) Size() int {
+	return h.outputLength
+}
+
+// BlockSize returns the hash's underlying block size.
+func (h *Hasher) BlockSize() int {
+	return rate128
+}
+
+// ******** Private functions ********
+
+// hashLeaves hashes the chunks in data in parallel and writes their chaining values to the final node.
+// The last chunk may be shorter than the chunk size.
+func (h *Hasher) hashLeaves(data []byte) {
+	leafCount := (len(data) + chunkSize - 1) / chunkSize
+	if leafCount == 0 {
+		return
+	}
+
+	chainingValues := make([]byte, leafCount*chainingValueSize)
+
+	workerCount := min(runtime.NumCPU(), leafCount)
+	leavesPerWorker := (leafCount + workerCount - 1) / workerCount
+
+	var wg sync.WaitGroup
+	for first := 0; first < leafCount; first += leavesPerWorker {
+		last := min(first+leavesPerWorker, leafCount)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			leaf := sponge{
+				rate:   rate128,
+				rounds: turboSHAKERounds,
+				domain: domainLeaf,
+			}
+
+			for i := first; i < last; i++ {
+				leaf.reset()
+				leaf.write(data[i*chunkSize : min((i+1)*chunkSize, len(data))])
+				leaf.read(chainingValues[i*chainingValueSize : (i+1)*chainingValueSize])
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	h.final.write(chainingValues)
+	h.leafCount += uint64(leafCount)
+}
+
+// lengthEncode encodes x as a big-endian byte string without leading zeros followed by its length.
+func lengthEncode(x uint64) []byte {
+	var result []byte
+	for ; x > 0; x >>= 8 {
+		result = append([]byte{byte(x)}, result...)
+	}
+
+	return append(result, byte(len(result)))
+}
@@ -0,0 +1,158 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package k12
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// ******** Private types ********
+
+// kt128Vector is a KangarooTwelve test vector.
+// The message and the customization string are the pattern 00 01 02 ... f9 fa 00 01 ...
+// of the given length, unless the message consists of 0xff bytes.
+// The expected value contains the last bytes of the output.
+type kt128Vector struct {
+	messageLength       int
+	messageIsFF         bool
+	customizationLength int
+	outputLength        int
+	expected            string
+}
+
+// turboSHAKEVector is a TurboSHAKE test vector with an empty message and the default domain.
+// The expected value contains the last bytes of the output.
+type turboSHAKEVector struct {
+	newTurboSHAKE func(int) *TurboSHAKE
+	name          string
+	outputLength  int
+	expected      string
+}
+
+// ******** Private variables ********
+
+// The test vectors are from RFC 9861, section 5.
+
+// kt128Vectors contains the KT128 test vectors.
+var kt128Vectors = []kt128Vector{
+	{messageLength: 0, customizationLength: 0, outputLength: 32, expected: `1ac2d450fc3b4205d19da7bfca1b37513c0803577ac7167f06fe2ce1f0ef39e5`},
+	{messageLength: 0, customizationLength: 0, outputLength: 64, expected: `1ac2d450fc3b4205d19da7bfca1b37513c0803577ac7167f06fe2ce1f0ef39e54269c056b8c82e48276038b6d292966cc07a3d4645272e31ff38508139eb0a71`},
+	{messageLength: 0, customizationLength: 0, outputLength: 10032, expected: `e8dc563642f7228c84684c898405d3a834799158c079b12880277a1d28e2ff6d`},
+	{messageLength: 1, customizationLength: 0, outputLength: 32, expected: `2bda92450e8b147f8a7cb629e784a058efca7cf7d8218e02d345dfaa65244a1f`},
+	{messageLength: 17, customizationLength: 0, outputLength: 32, expected: `6bf75fa2239198db4772e36478f8e19b0f371205f6a9a93a273f51df37122888`},
+	{messageLength: 17 * 17, customizationLength: 0, outputLength: 32, expected: `0c315ebcdedbf61426de7dcf8fb725d1e74675d7f5327a5067f367b108ecb67c`},
+	{messageLength: 17 * 17 * 17, customizationLength: 0, outputLength: 32, expected: `cb552e2ec77d9910701d578b457ddf772c12e322e4ee7fe417f92c758f0d59d0`},
+	{messageLength: 17 * 17 * 17 * 17, customizationLength: 0, outputLength: 32, expected: `8701045e22205345ff4dda05555cbb5c3af1a771c2b89baef37db43d9998b9fe`},
+	{messageLength: 17 * 17 * 17 * 17 * 17, customizationLength: 0, outputLength: 32, expected: `844d610933b1b9963cbdeb5ae3b6b05cc7cbd67ceedf883eb678a0a8e0371682`},
+	{messageLength: 17 * 17 * 17 * 17 * 17 * 17, customizationLength: 0, outputLength: 32, expected: `3c390782a8a4e89fa6367f72feaaf13255c8d95878481d3cd8ce85f58e880af8`},
+	{messageLength: 0, customizationLength: 1, outputLength: 32, expected: `fab658db63e94a246188bf7af69a133045f46ee984c56e3c3328caaf1aa1a583`},
+	{messageLength: 1, messageIsFF: true, customizationLength: 41, outputLength: 32, expected: `d848c5068ced736f4462159b9867fd4c20b808acc3d5bc48e0b06ba0a3762ec4`},
+	{messageLength: 3, messageIsFF: true, customizationLength: 41 * 41, outputLength: 32, expected: `c389e5009ae57120854c2e8c64670ac01358cf4c1baf89447a724234dc7ced74`},
+	{messageLength: 7, messageIsFF: true, customizationLength: 41 * 41 * 41, outputLength: 32, expected: `75d2f86a2e644566726b4fbcfc5657b9dbcf070c7b0dca06450ab291d7443bcf`},
+	{messageLength: 8191, customizationLength: 0, outputLength: 32, expected: `1b577636f723643e990cc7d6a659837436fd6a103626600eb8301cd1dbe553d6`},
+	{messageLength: 8192, customizationLength: 0, outputLength: 32, expected: `48f256f6772f9edfb6a8b661ec92dc93b95ebd05a08a17b39ae3490870c926c3`},
+	{messageLength: 8192, customizationLength: 8189, outputLength: 32, expected: `3ed12f70fb05ddb58689510ab3e4d23c6c6033849aa01e1d8c220a297fedcd0b`},
+	{messageLength: 8192, customizationLength: 8190, outputLength: 32, expected: `6a7c1b6a5cd0d8c9ca943a4a216cc64604559a2ea45f78570a15253d67ba00ae`},
+}
+
+// turboSHAKEVectors contains the TurboSHAKE test vectors.
+var turboSHAKEVectors = []turboSHAKEVector{
+	{newTurboSHAKE: NewTurboSHAKE128, name: `TurboSHAKE128`, outputLength: 32, expected: `1e415f1c5983aff2169217277d17bb538cd945a397ddec541f1ce41af2c1b74c`},
+	{newTurboSHAKE: NewTurboSHAKE128, name: `TurboSHAKE128`, outputLength: 64, expected: `1e415f1c5983aff2169217277d17bb538cd945a397ddec541f1ce41af2c1b74c3e8ccae2a4dae56c84a04c2385c03c15e8193bdf58737363321691c05462c8df`},
+	{newTurboSHAKE: NewTurboSHAKE128, name: `TurboSHAKE128`, outputLength: 10032, expected: `a3b9b0385900ce761f22aed548e754da10a5242d62e8c658e3f3a923a7555607`},
+	{newTurboSHAKE: NewTurboSHAKE256, name: `TurboSHAKE256`, outputLength: 64, expected: `367a329dafea871c7802ec67f905ae13c57695dc2c6663c61035f59a18f8e7db11edc0e12e91ea60eb6b32df06dd7f002fbafabb6e13ec1cc20d995547600db0`},
+	{newTurboSHAKE: NewTurboSHAKE256, name: `TurboSHAKE256`, outputLength: 10032, expected: `abefa11630c661269249742685ec082f207265dccf2f43534e9c61ba0c9d1d75`},
+}
+
+// ******** Test functions ********
+
+// TestKT128 tests KangarooTwelve with the RFC 9861 test vectors.
+func TestKT128(t *testing.T) {
+	for _, v := range kt128Vectors {
+		var message []byte
+		if v.messageIsFF {
+			message = make([]byte, v.messageLength)
+			for i := range message {
+				message[i] = 0xff
+			}
+		} else {
+			message = pattern(v.messageLength)
+		}
+
+		h := New(pattern(v.customizationLength), v.outputLength)
+
+		_, _ = h.Write(message)
+		checkResult(t, v, `at once`, h.Sum(nil))
+
+		h.Reset()
+		for p := message; len(p) > 0; {
+			n := min(len(p), 1000)
+			_, _ = h.Write(p[:n])
+			p = p[n:]
+		}
+
+		checkResult(t, v, `in pieces`, h.Sum(nil))
+	}
+}
+
+// TestTurboSHAKE tests TurboSHAKE with the RFC 9861 test vectors.
+func TestTurboSHAKE(t *testing.T) {
+	for _, v := range turboSHAKEVectors {
+		output := v.newTurboSHAKE(v.outputLength).Sum(nil)
+
+		result := hex.EncodeToString(output[len(output)-len(v.expected)/2:])
+		if result != v.expected {
+			t.Errorf(`%s with output length %d: got %s, expected %s`, v.name, v.outputLength, result, v.expected)
+		}
+	}
+}
+
+// ******** Private functions ********
+
+// checkResult compares the end of a KangarooTwelve output with the expected value.
+func checkResult(t *testing.T, v kt128Vector, mode string, output []byte) {
+	t.Helper()
+
+	result := hex.EncodeToString(output[len(output)-len(v.expected)/2:])
+	if result != v.expected {
+		t.Errorf(`Message length %d, customization length %d, output length %d %s: got %s, expected %s`,
+			v.messageLength, v.customizationLength, v.outputLength, mode, result, v.expected)
+	}
+}
+
+// pattern returns the test pattern of the given length.
+func pattern(length int) []byte {
+	result := make([]byte, length)
+	for i := range result {
+		result[i] = byte(i % 251)
+	}
+
+	return result
+}
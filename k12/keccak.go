@@ -0,0 +1,209 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package k12
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// ******** Private constants ********
+
+// maxRounds is the number of rounds of Keccak-f[1600].
+const maxRounds = 24
+
+// ******** Private variables ********
+
+// roundConstants contains the round constants of Keccak-f[1600].
+// Keccak-p[1600, n] uses the last n of them.
+var roundConstants = [maxRounds]uint64{
+	0x0000000000000001, 0x0000000000008082, 0x800000000000808a, 0x8000000080008000,
+	0x000000000000808b, 0x0000000080000001, 0x8000000080008081, 0x8000000000008009,
+	0x000000000000008a, 0x0000000000000088, 0x0000000080008009, 0x000000008000000a,
+	0x000000008000808b, 0x800000000000008b, 0x8000000000008089, 0x8000000000008003,
+	0x8000000000008002, 0x8000000000000080, 0x000000000000800a, 0x800000008000000a,
+	0x8000000080008081, 0x8000000000008080, 0x0000000080000001, 0x8000000080008008,
+}
+
+// ******** Private types ********
+
+// sponge is a Keccak-p[1600] sponge with a domain separation byte.
+// The domain separation byte already contains the first bit of the padding.
+type sponge struct {
+	state     [25]uint64
+	rate      int
+	rounds    int
+	domain    byte
+	position  int
+	squeezing bool
+}
+
+// ******** Private functions ********
+
+// -------- sponge methods --------
+
+// write absorbs data into the sponge.
+func (s *sponge) write(p []byte) {
+	for len(p) > 0 {
+		if s.position == 0 && len(p) >= s.rate {
+			for i := 0; i < s.rate; i += 8 {
+				s.state[i>>3] ^= binary.LittleEndian.Uint64(p[i:])
+			}
+
+			keccakP1600(&s.state, s.rounds)
+			p = p[s.rate:]
+
+			continue
+		}
+
+		s.state[s.position>>3] ^= uint64(p[0]) << ((s.position & 7) << 3)
+		s.position++
+		p = p[1:]
+
+		if s.position == s.rate {
+			keccakP1600(&s.state, s.rounds)
+			s.position = 0
+		}
+	}
+}
+
+// read squeezes data from the sponge. The first read finishes the absorption.
+func (s *sponge) read(p []byte) {
+	if !s.squeezing {
+		s.xorByte(s.position, s.domain)
+		s.xorByte(s.rate-1, 0x80)
+		keccakP1600(&s.state, s.rounds)
+		s.position = 0
+		s.squeezing = true
+	}
+
+	for len(p) > 0 {
+		if s.position == s.rate {
+			keccakP1600(&s.state, s.rounds)
+			s.position = 0
+		}
+
+		p[0] = byte(s.state[s.position>>3] >> ((s.position & 7) << 3))
+		s.position++
+		p = p[1:]
+	}
+}
+
+// reset resets the sponge to its initial state.
+func (s *sponge) reset() {
+	s.state = [25]uint64{}
+	s.position = 0
+	s.squeezing = false
+}
+
+// xorByte xors a byte into the state at the given byte position.
+func (s *sponge) xorByte(position int, b byte) {
+	s.state[position>>3] ^= uint64(b) << ((position & 7) << 3)
+}
+
+// -------- Permutation --------
+
+// keccakP1600 applies the Keccak-p[1600] permutation with the given number of rounds to the state.
+func keccakP1600(a *[25]uint64, rounds int) {
+	// The lanes are kept in local variables, so that the compiler can hold them in registers.
+	a00, a01, a02, a03, a04, a05, a06, a07, a08, a09, a10, a11, a12, a13, a14, a15, a16, a17, a18, a19, a20, a21, a22, a23, a24 := a[0], a[1], a[2], a[3], a[4], a[5], a[6], a[7], a[8], a[9], a[10], a[11], a[12], a[13], a[14], a[15], a[16], a[17], a[18], a[19], a[20], a[21], a[22], a[23], a[24]
+
+	for round := maxRounds - rounds; round < maxRounds; round++ {
+		// Theta
+		c0 := a00 ^ a05 ^ a10 ^ a15 ^ a20
+		c1 := a01 ^ a06 ^ a11 ^ a16 ^ a21
+		c2 := a02 ^ a07 ^ a12 ^ a17 ^ a22
+		c3 := a03 ^ a08 ^ a13 ^ a18 ^ a23
+		c4 := a04 ^ a09 ^ a14 ^ a19 ^ a24
+		d0 := c4 ^ bits.RotateLeft64(c1, 1)
+		d1 := c0 ^ bits.RotateLeft64(c2, 1)
+		d2 := c1 ^ bits.RotateLeft64(c3, 1)
+		d3 := c2 ^ bits.RotateLeft64(c4, 1)
+		d4 := c3 ^ bits.RotateLeft64(c0, 1)
+
+		// Rho and pi
+		b00 := a00 ^ d0
+		b10 := bits.RotateLeft64(a01^d1, 1)
+		b20 := bits.RotateLeft64(a02^d2, 62)
+		b05 := bits.RotateLeft64(a03^d3, 28)
+		b15 := bits.RotateLeft64(a04^d4, 27)
+		b16 := bits.RotateLeft64(a05^d0, 36)
+		b01 := bits.RotateLeft64(a06^d1, 44)
+		b11 := bits.RotateLeft64(a07^d2, 6)
+		b21 := bits.RotateLeft64(a08^d3, 55)
+		b06 := bits.RotateLeft64(a09^d4, 20)
+		b07 := bits.RotateLeft64(a10^d0, 3)
+		b17 := bits.RotateLeft64(a11^d1, 10)
+		b02 := bits.RotateLeft64(a12^d2, 43)
+		b12 := bits.RotateLeft64(a13^d3, 25)
+		b22 := bits.RotateLeft64(a14^d4, 39)
+		b23 := bits.RotateLeft64(a15^d0, 41)
+		b08 := bits.RotateLeft64(a16^d1, 45)
+		b18 := bits.RotateLeft64(a17^d2, 15)
+		b03 := bits.RotateLeft64(a18^d3, 21)
+		b13 := bits.RotateLeft64(a19^d4, 8)
+		b14 := bits.RotateLeft64(a20^d0, 18)
+		b24 := bits.RotateLeft64(a21^d1, 2)
+		b09 := bits.RotateLeft64(a22^d2, 61)
+		b19 := bits.RotateLeft64(a23^d3, 56)
+		b04 := bits.RotateLeft64(a24^d4, 14)
+
+		// Chi
+		a00 = b00 ^ (^b01 & b02)
+		a01 = b01 ^ (^b02 & b03)
+		a02 = b02 ^ (^b03 & b04)
+		a03 = b03 ^ (^b04 & b00)
+		a04 = b04 ^ (^b00 & b01)
+		a05 = b05 ^ (^b06 & b07)
+		a06 = b06 ^ (^b07 & b08)
+		a07 = b07 ^ (^b08 & b09)
+		a08 = b08 ^ (^b09 & b05)
+		a09 = b09 ^ (^b05 & b06)
+		a10 = b10 ^ (^b11 & b12)
+		a11 = b11 ^ (^b12 & b13)
+		a12 = b12 ^ (^b13 & b14)
+		a13 = b13 ^ (^b14 & b10)
+		a14 = b14 ^ (^b10 & b11)
+		a15 = b15 ^ (^b16 & b17)
+		a16 = b16 ^ (^b17 & b18)
+		a17 = b17 ^ (^b18 & b19)
+		a18 = b18 ^ (^b19 & b15)
+		a19 = b19 ^ (^b15 & b16)
+		a20 = b20 ^ (^b21 & b22)
+		a21 = b21 ^ (^b22 & b23)
+		a22 = b22 ^ (^b23 & b24)
+		a23 = b23 ^ (^b24 & b20)
+		a24 = b24 ^ (^b20 & b21)
+
+		// Iota
+		a00 ^= roundConstants[round]
+	}
+
+	a[0], a[1], a[2], a[3], a[4], a[5], a[6], a[7], a[8], a[9], a[10], a[11], a[12], a[13], a[14], a[15], a[16], a[17], a[18], a[19], a[20], a[21], a[22], a[23], a[24] = a00, a01, a02, a03, a04, a05, a06, a07, a08, a09, a10, a11, a12, a13, a14, a15, a16, a17, a18, a19, a20, a21, a22, a23, a24
+}
@@ -0,0 +1,140 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package k12
+
+import (
+	"slices"
+)
+
+// ******** Public constants ********
+
+// DefaultDomain is the domain separation byte of TurboSHAKE, if it is used as a plain extendable-output function.
+const DefaultDomain = 0x1f
+
+// Size128 is the default size of a TurboSHAKE128 hash value in bytes.
+const Size128 = 32
+
+// Size256 is the default size of a TurboSHAKE256 hash value in bytes.
+const Size256 = 64
+
+// ******** Private constants ********
+
+// turboSHAKERounds is the number of rounds of the permutation used by TurboSHAKE.
+const turboSHAKERounds = 12
+
+// These are the rates of the TurboSHAKE functions in bytes.
+const (
+	rate128 = 168
+	rate256 = 136
+)
+
+// ******** Public types ********
+
+// TurboSHAKE implements the TurboSHAKE extendable-output functions.
+type TurboSHAKE struct {
+	sponge       sponge
+	outputLength int
+}
+
+// ******** Public functions ********
+
+// NewTurboSHAKE128 creates a new TurboSHAKE128 function with the given output length in bytes.
+// If the output length is 0, the default size is used.
+func NewTurboSHAKE128(outputLength int) *TurboSHAKE {
+	return newTurboSHAKE(rate128, DefaultDomain, outputLength, Size128)
+}
+
+// NewTurboSHAKE256 creates a new TurboSHAKE256 function with the given output length in bytes.
+// If the output length is 0, the default size is used.
+func NewTurboSHAKE256(outputLength int) *TurboSHAKE {
+	return newTurboSHAKE(rate256, DefaultDomain, outputLength, Size256)
+}
+
+// -------- TurboSHAKE methods --------
+
+// Write adds more data to the running hash.
+// It must not be called after Read.
+func (t *TurboSHAKE) Write(p []byte) (int, error) {
+	t.sponge.write(p)
+
+	return len(p), nil
+}
+
+// Read reads more output from the extendable-output function.
+// After the first call to Read no more data can be written.
+func (t *TurboSHAKE) Read(p []byte) (int, error) {
+	t.sponge.read(p)
+
+	return len(p), nil
+}
+
+// Sum appends the current hash value to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (t *TurboSHAKE) Sum(b []byte) []byte {
+	result := slices.Grow(b, t.outputLength)[:len(b)+t.outputLength]
+
+	// Reading changes the state. So read from a copy.
+	c := t.sponge
+	c.read(result[len(b):])
+
+	return result
+}
+
+// Reset resets the hash to its initial state.
+func (t *TurboSHAKE) Reset() {
+	t.sponge.reset()
+}
+
+// Size returns the number of bytes Sum will return.
+func (t *TurboSHAKE) Size() int {
+	return t.outputLength
+}
+
+// BlockSize returns the hash's underlying block size.
+func (t *TurboSHAKE) BlockSize() int {
+	return t.sponge.rate
+}
+
+// ******** Private functions ********
+
+// newTurboSHAKE creates a new TurboSHAKE function with the given rate and domain separation byte.
+func newTurboSHAKE(rate int, domain byte, outputLength int, defaultOutputLength int) *TurboSHAKE {
+	if outputLength == 0 {
+		outputLength = defaultOutputLength
+	}
+
+	return &TurboSHAKE{
+		sponge: sponge{
+			rate:   rate,
+			rounds: turboSHAKERounds,
+			domain: domain,
+		},
+		outputLength: outputLength,
+	}
+}
@@ -0,0 +1,138 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"hashvalue/hashfactory"
+	"os"
+	"text/tabwriter"
+)
+
+// ******** Private constants ********
+
+// These are the valid list formats.
+const (
+	listFormatText = `text`
+	listFormatJSON = `json`
+)
+
+// listColumnPadding is the number of spaces between the columns of the text list.
+const listColumnPadding = 2
+
+// ******** Private functions ********
+
+// printAlgorithmList prints the descriptors of all known algorithms in the list format.
+func printAlgorithmList() int {
+	descriptors := hashfactory.Descriptors()
+
+	switch listFormat {
+	case listFormatText:
+		return printAlgorithmTable(descriptors)
+
+	case listFormatJSON:
+		return printAlgorithmJSON(descriptors)
+
+	default:
+		return printUsageErrorf(`Invalid list format '%s'`, listFormat)
+	}
+}
+
+// printAlgorithmTable prints the descriptors as a text table.
+func printAlgorithmTable(descriptors []hashfactory.Descriptor) int {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, listColumnPadding, ' ', 0)
+
+	_, _ = fmt.Fprintln(w, "Name\tFamily\tDigest size\tBlock size\tSecurity\tKey\tVariable length\tOID")
+
+	for _, d := range descriptors {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\t%s\t%s\t%s\n",
+			d.Name,
+			d.Family,
+			d.DigestSize,
+			d.BlockSize,
+			d.Security,
+			keyUsageText(d),
+			yesNo(d.VariableOutputLength),
+			oidText(d.OID),
+		)
+	}
+
+	err := w.Flush()
+	if err != nil {
+		return printErrorf(`Error printing algorithm list: %v`, err)
+	}
+
+	return rcOK
+}
+
+// printAlgorithmJSON prints the descriptors as a JSON array.
+func printAlgorithmJSON(descriptors []hashfactory.Descriptor) int {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent(``, `  `)
+
+	err := encoder.Encode(descriptors)
+	if err != nil {
+		return printErrorf(`Error printing algorithm list: %v`, err)
+	}
+
+	return rcOK
+}
+
+// keyUsageText returns the text that describes whether an algorithm can or has to be used with a key.
+func keyUsageText(d hashfactory.Descriptor) string {
+	switch {
+	case d.KeyRequired:
+		return `required`
+
+	case d.KeySupported:
+		return `optional`
+
+	default:
+		return `no`
+	}
+}
+
+// oidText returns the object identifier or '-', if there is none.
+func oidText(oid string) string {
+	if len(oid) == 0 {
+		return `-`
+	}
+
+	return oid
+}
+
+// yesNo returns 'yes' for true and 'no' for false.
+func yesNo(b bool) string {
+	if b {
+		return `yes`
+	}
+
+	return `no`
+}
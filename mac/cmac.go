@@ -0,0 +1,158 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package mac
+
+import (
+	"crypto/cipher"
+	"crypto/subtle"
+)
+
+// ******** Private constants ********
+
+// cmacRb is the constant that is added when the doubling of a subkey overflows.
+const cmacRb = 0x87
+
+// ******** Public types ********
+
+// CMAC implements the AES-CMAC message authentication code.
+type CMAC struct {
+	block cipher.Block
+	k1    [BlockSize]byte
+	k2    [BlockSize]byte
+	x     [BlockSize]byte
+	// The last block is kept in the buffer, as it is processed differently.
+	buffer       [BlockSize]byte
+	bufferedSize int
+}
+
+// ******** Public functions ********
+
+// NewCMAC creates a new AES-CMAC function with the given key.
+// The key size determines whether AES-128, AES-192 or AES-256 is used.
+func NewCMAC(key []byte) (*CMAC, error) {
+	block, err := newAES(key)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CMAC{block: block}
+
+	// 1. L = E(K, 0).
+	var l [BlockSize]byte
+	block.Encrypt(l[:], l[:])
+
+	// 2. K1 = L * x and K2 = K1 * x.
+	cmacDouble(&result.k1, &l)
+	cmacDouble(&result.k2, &result.k1)
+
+	return result, nil
+}
+
+// -------- CMAC methods --------
+
+// Write adds more data to the running message authentication code.
+func (m *CMAC) Write(p []byte) (int, error) {
+	n := len(p)
+
+	for len(p) != 0 {
+		// A full buffer is only processed when more data follows.
+		if m.bufferedSize == BlockSize {
+			m.processBlock(m.buffer[:])
+			m.bufferedSize = 0
+		}
+
+		// Process all full blocks except the last one directly.
+		for m.bufferedSize == 0 && len(p) > BlockSize {
+			m.processBlock(p[:BlockSize])
+			p = p[BlockSize:]
+		}
+
+		copied := copy(m.buffer[m.bufferedSize:], p)
+		m.bufferedSize += copied
+		p = p[copied:]
+	}
+
+	return n, nil
+}
+
+// Sum appends the current message authentication code to b and returns the resulting slice.
+// It does not change the underlying state.
+func (m *CMAC) Sum(b []byte) []byte {
+	var last [BlockSize]byte
+	copy(last[:], m.buffer[:m.bufferedSize])
+
+	subkey := &m.k1
+	if m.bufferedSize != BlockSize {
+		last[m.bufferedSize] = 0x80
+		subkey = &m.k2
+	}
+
+	subtle.XORBytes(last[:], last[:], subkey[:])
+	subtle.XORBytes(last[:], last[:], m.x[:])
+	m.block.Encrypt(last[:], last[:])
+
+	return append(b, last[:]...)
+}
+
+// Reset resets the message authentication code to its initial state.
+func (m *CMAC) Reset() {
+	clear(m.x[:])
+	clear(m.buffer[:])
+	m.bufferedSize = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (m *CMAC) Size() int {
+	return Size
+}
+
+// BlockSize returns the block size of the message authentication code.
+func (m *CMAC) BlockSize() int {
+	return BlockSize
+}
+
+// ******** Private functions ********
+
+// processBlock processes a block that is not the last block.
+func (m *CMAC) processBlock(block []byte) {
+	subtle.XORBytes(m.x[:], m.x[:], block)
+	m.block.Encrypt(m.x[:], m.x[:])
+}
+
+// cmacDouble multiplies the value in by x in GF(2^128) and stores the result in out.
+func cmacDouble(out *[BlockSize]byte, in *[BlockSize]byte) {
+	carry := in[0] >> 7
+
+	for i := 0; i < BlockSize-1; i++ {
+		out[i] = in[i]<<1 | in[i+1]>>7
+	}
+
+	// The reduction is done in constant time.
+	out[BlockSize-1] = in[BlockSize-1]<<1 ^ (-carry & cmacRb)
+}
@@ -0,0 +1,275 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package mac
+
+import (
+	"crypto/subtle"
+	"encoding/binary"
+)
+
+// ******** Private constants ********
+
+// gcmStandardNonceSize is the nonce size for which the pre-counter block is the nonce followed by a counter of 1.
+const gcmStandardNonceSize = 12
+
+// ******** Private variables ********
+
+// gcmReductionTable contains the reduction values for the four bits that are shifted out in a multiplication step.
+var gcmReductionTable = [16]uint16{
+	0x0000, 0x1c20, 0x3840, 0x2460, 0x7080, 0x6ca0, 0x48c0, 0x54e0,
+	0xe100, 0xfd20, 0xd940, 0xc560, 0x9180, 0x8da0, 0xa9c0, 0xb5e0,
+}
+
+// ******** Private types ********
+
+// fieldElement is an element of GF(2^128) in the bit order of GCM.
+// The coefficient of x^0 is the most significant bit of low.
+type fieldElement struct {
+	low, high uint64
+}
+
+// ******** Public types ********
+
+// GMAC implements the GMAC message authentication code, i.e. GCM with an empty plaintext.
+// The message is the additional authenticated data.
+type GMAC struct {
+	// productTable contains the products of the hash key with all 4 bit values.
+	productTable [16]fieldElement
+	tagMask      [Size]byte
+	y            fieldElement
+	buffer       [BlockSize]byte
+	bufferedSize int
+	totalLength  uint64
+}
+
+// ******** Public functions ********
+
+// NewGMAC creates a new GMAC function with the given AES key and nonce.
+// The key size determines whether AES-128, AES-192 or AES-256 is used.
+// The nonce may have any length greater than 0. The recommended length is 12 bytes.
+func NewGMAC(key []byte, nonce []byte) (*GMAC, error) {
+	block, err := newAES(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(nonce) == 0 {
+		return nil, ErrNonceEmpty
+	}
+
+	result := &GMAC{}
+
+	// 1. The hash key is H = E(K, 0).
+	var h [BlockSize]byte
+	block.Encrypt(h[:], h[:])
+	result.initProductTable(&h)
+
+	// 2. The pre-counter block J0 is derived from the nonce.
+	var j0 [BlockSize]byte
+	if len(nonce) == gcmStandardNonceSize {
+		copy(j0[:], nonce)
+		j0[BlockSize-1] = 1
+	} else {
+		var y fieldElement
+		result.updateBlocks(&y, nonce[:len(nonce)&^(BlockSize-1)])
+
+		rest := nonce[len(nonce)&^(BlockSize-1):]
+		if len(rest) != 0 {
+			var last [BlockSize]byte
+			copy(last[:], rest)
+			result.updateBlocks(&y, last[:])
+		}
+
+		y.high ^= uint64(len(nonce)) << 3
+		result.mul(&y)
+		y.put(j0[:])
+	}
+
+	// 3. The tag is masked with E(K, J0).
+	block.Encrypt(result.tagMask[:], j0[:])
+
+	return result, nil
+}
+
+// -------- GMAC methods --------
+
+// Write adds more data to the running message authentication code.
+func (m *GMAC) Write(p []byte) (int, error) {
+	n := len(p)
+	m.totalLength += uint64(n)
+
+	if m.bufferedSize != 0 {
+		copied := copy(m.buffer[m.bufferedSize:], p)
+		m.bufferedSize += copied
+		p = p[copied:]
+
+		if m.bufferedSize < BlockSize {
+			return n, nil
+		}
+
+		m.updateBlocks(&m.y, m.buffer[:])
+		m.bufferedSize = 0
+	}
+
+	fullSize := len(p) &^ (BlockSize - 1)
+	m.updateBlocks(&m.y, p[:fullSize])
+
+	m.bufferedSize = copy(m.buffer[:], p[fullSize:])
+
+	return n, nil
+}
+
+// Sum appends the current message authentication code to b and returns the resulting slice.
+// It does not change the underlying state.
+func (m *GMAC) Sum(b []byte) []byte {
+	y := m.y
+
+	if m.bufferedSize != 0 {
+		var last [BlockSize]byte
+		copy(last[:], m.buffer[:m.bufferedSize])
+		m.updateBlocks(&y, last[:])
+	}
+
+	// The length block contains the bit lengths of the additional data and of the empty ciphertext.
+	y.low ^= m.totalLength << 3
+	m.mul(&y)
+
+	var tag [Size]byte
+	y.put(tag[:])
+	subtle.XORBytes(tag[:], tag[:], m.tagMask[:])
+
+	return append(b, tag[:]...)
+}
+
+// Reset resets the message authentication code to its initial state.
+func (m *GMAC) Reset() {
+	m.y = fieldElement{}
+	clear(m.buffer[:])
+	m.bufferedSize = 0
+	m.totalLength = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (m *GMAC) Size() int {
+	return Size
+}
+
+// BlockSize returns the block size of the message authentication code.
+func (m *GMAC) BlockSize() int {
+	return BlockSize
+}
+
+// ******** Private functions ********
+
+// initProductTable calculates the products of the hash key h with all 4 bit values.
+// The table is indexed by the bit-reversed 4 bit value, as the bit order of GCM is reversed.
+func (m *GMAC) initProductTable(h *[BlockSize]byte) {
+	x := fieldElement{
+		low:  binary.BigEndian.Uint64(h[:8]),
+		high: binary.BigEndian.Uint64(h[8:]),
+	}
+
+	m.productTable[reverseBits(1)] = x
+	for i := 2; i < 16; i += 2 {
+		m.productTable[reverseBits(i)] = m.productTable[reverseBits(i>>1)].double()
+		m.productTable[reverseBits(i+1)] = m.productTable[reverseBits(i)].add(x)
+	}
+}
+
+// updateBlocks adds the blocks to y and multiplies y by the hash key after each block.
+// The length of blocks must be a multiple of BlockSize.
+func (m *GMAC) updateBlocks(y *fieldElement, blocks []byte) {
+	for len(blocks) != 0 {
+		y.low ^= binary.BigEndian.Uint64(blocks)
+		y.high ^= binary.BigEndian.Uint64(blocks[8:])
+		m.mul(y)
+		blocks = blocks[BlockSize:]
+	}
+}
+
+// mul multiplies y by the hash key.
+func (m *GMAC) mul(y *fieldElement) {
+	var z fieldElement
+
+	for i := range 2 {
+		word := y.high
+		if i == 1 {
+			word = y.low
+		}
+
+		for j := 0; j < 64; j += 4 {
+			msw := z.high & 0xf
+			z.high >>= 4
+			z.high |= z.low << 60
+			z.low >>= 4
+			z.low ^= uint64(gcmReductionTable[msw]) << 48
+
+			t := &m.productTable[word&0xf]
+			z.low ^= t.low
+			z.high ^= t.high
+
+			word >>= 4
+		}
+	}
+
+	*y = z
+}
+
+// add returns the sum of two field elements.
+func (x fieldElement) add(y fieldElement) fieldElement {
+	return fieldElement{low: x.low ^ y.low, high: x.high ^ y.high}
+}
+
+// double returns the field element multiplied by x.
+func (x fieldElement) double() fieldElement {
+	result := fieldElement{
+		low:  x.low >> 1,
+		high: x.high>>1 | x.low<<63,
+	}
+
+	if x.high&1 != 0 {
+		result.low ^= 0xe100000000000000
+	}
+
+	return result
+}
+
+// put stores the field element in b in big-endian byte order.
+func (x fieldElement) put(b []byte) {
+	binary.BigEndian.PutUint64(b, x.low)
+	binary.BigEndian.PutUint64(b[8:], x.high)
+}
+
+// reverseBits reverses the order of the lowest 4 bits of i.
+func reverseBits(i int) int {
+	i = ((i << 2) & 0xc) | ((i >> 2) & 0x3)
+	i = ((i << 1) & 0xa) | ((i >> 1) & 0x5)
+
+	return i
+}
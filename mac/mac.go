@@ -0,0 +1,71 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+// Package mac implements the message authentication codes AES-CMAC (RFC 4493),
+// GMAC (NIST SP 800-38D) and Poly1305 (RFC 8439).
+//
+// All of them implement the hash.Hash interface, so that they can be used like a keyed hash.
+package mac
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"errors"
+)
+
+// ******** Public constants ********
+
+// BlockSize is the block size of all message authentication codes in this package in bytes.
+const BlockSize = 16
+
+// Size is the size of all message authentication codes in this package in bytes.
+const Size = 16
+
+// ******** Public variables ********
+
+// ErrAESKeySize is returned when an AES key is not 16, 24 or 32 bytes long.
+var ErrAESKeySize = errors.New(`mac: AES key must be 16, 24 or 32 bytes long`)
+
+// ErrNonceEmpty is returned when a GMAC nonce is empty.
+var ErrNonceEmpty = errors.New(`mac: nonce must not be empty`)
+
+// ErrPoly1305KeySize is returned when a Poly1305 key is not 32 bytes long.
+var ErrPoly1305KeySize = errors.New(`mac: Poly1305 key must be 32 bytes long`)
+
+// ******** Private functions ********
+
+// newAES creates an AES block cipher and maps the key size error to ErrAESKeySize.
+func newAES(key []byte) (cipher.Block, error) {
+	switch len(key) {
+	case 16, 24, 32:
+		return aes.NewCipher(key)
+
+	default:
+		return nil, ErrAESKeySize
+	}
+}
@@ -0,0 +1,206 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package mac
+
+import (
+	"encoding/hex"
+	"errors"
+	"hash"
+	"testing"
+)
+
+// ******** Private types ********
+
+// cmacVector is an AES-CMAC test vector. The message consists of the first bytes of cmacMessage.
+type cmacVector struct {
+	key           string
+	messageLength int
+	expected      string
+}
+
+// gmacVector is a GMAC test vector.
+type gmacVector struct {
+	key      string
+	nonce    string
+	message  string
+	expected string
+}
+
+// keyError is a set of a key and a nonce that must be rejected.
+type keyError struct {
+	name          string
+	create        func() error
+	expectedError error
+}
+
+// ******** Private constants ********
+
+// cmacMessage is the message of the AES-CMAC examples.
+const cmacMessage = `6bc1bee22e409f96e93d7e117393172aae2d8a571e03ac9c9eb76fac45af8e5130c81c46a35ce411e5fbc1191a0a52eff69f2445df4f9b17ad2b417be66c3710`
+
+// ******** Private variables ********
+
+// cmacVectors contains the AES-CMAC examples from NIST SP 800-38B, appendix D.
+var cmacVectors = []cmacVector{
+	{key: `2b7e151628aed2a6abf7158809cf4f3c`, messageLength: 0, expected: `bb1d6929e95937287fa37d129b756746`},
+	{key: `2b7e151628aed2a6abf7158809cf4f3c`, messageLength: 16, expected: `070a16b46b4d4144f79bdd9dd04a287c`},
+	{key: `2b7e151628aed2a6abf7158809cf4f3c`, messageLength: 40, expected: `dfa66747de9ae63030ca32611497c827`},
+	{key: `2b7e151628aed2a6abf7158809cf4f3c`, messageLength: 64, expected: `51f0bebf7e3b9d92fc49741779363cfe`},
+	{key: `8e73b0f7da0e6452c810f32b809079e562f8ead2522c6b7b`, messageLength: 0, expected: `d17ddf46adaacde531cac483de7a9367`},
+	{key: `8e73b0f7da0e6452c810f32b809079e562f8ead2522c6b7b`, messageLength: 16, expected: `9e99a7bf31e710900662f65e617c5184`},
+	{key: `8e73b0f7da0e6452c810f32b809079e562f8ead2522c6b7b`, messageLength: 40, expected: `8a1de5be2eb31aad089a82e6ee908b0e`},
+	{key: `8e73b0f7da0e6452c810f32b809079e562f8ead2522c6b7b`, messageLength: 64, expected: `a1d5df0eed790f794d77589659f39a11`},
+	{key: `603deb1015ca71be2b73aef0857d77811f352c073b6108d72d9810a30914dff4`, messageLength: 0, expected: `028962f61b7bf89efc6b551f4667d983`},
+	{key: `603deb1015ca71be2b73aef0857d77811f352c073b6108d72d9810a30914dff4`, messageLength: 16, expected: `28a7023f452e8f82bd4bf28d8c37c35c`},
+	{key: `603deb1015ca71be2b73aef0857d77811f352c073b6108d72d9810a30914dff4`, messageLength: 40, expected: `aaf3d8f1de5640c232f5b169b9c911e6`},
+	{key: `603deb1015ca71be2b73aef0857d77811f352c073b6108d72d9810a30914dff4`, messageLength: 64, expected: `e1992190549f6ed5696a2c056c315410`},
+}
+
+// gmacVectors contains GCM test vectors with an empty plaintext, where the tag is the GMAC of the additional data.
+// The first vector is test case 1 of the GCM specification and the second one is from the NIST CAVP file gcmEncryptExtIV128.rsp.
+// The other vectors have nonces that are not 12 bytes long. They have been calculated with the GCM implementation of the Go standard library.
+var gmacVectors = []gmacVector{
+	{
+		key:      `00000000000000000000000000000000`,
+		nonce:    `000000000000000000000000`,
+		message:  ``,
+		expected: `58e2fccefa7e3061367f1d57a4e7455a`,
+	},
+	{
+		key:      `77be63708971c4e240d1cb79e8d77feb`,
+		nonce:    `e0e00f19fed7ba0136a797f3`,
+		message:  `7a43ec1d9c0a5a78a0b16533a6213cab`,
+		expected: `209fcc8d3675ed938e9c7166709dd946`,
+	},
+	{
+		key:      `feffe9928665731c6d6a8f9467308308`,
+		nonce:    `9313225df88406e555909c5aff5269aa6a7a9538534f7da1e4c303d2a318a728c3c0c95156809539fcf0e2429a6b525416aedbf5a0de6a57a637b39b`,
+		message:  `feedfacedeadbeeffeedfacedeadbeefabaddad2`,
+		expected: `7be5178ff2b73c7d6f8b4dfdde8437ec`,
+	},
+	{
+		key:      `feffe9928665731c6d6a8f9467308308feffe9928665731c6d6a8f9467308308`,
+		nonce:    `cafebabefacedbad`,
+		message:  `feedfacedeadbeeffeedfacedeadbeefabaddad2`,
+		expected: `2dd775575822838efa7652ae6cd0c455`,
+	},
+}
+
+// keyErrors contains keys and nonces with invalid lengths.
+var keyErrors = []keyError{
+	{name: `CMAC with 15 byte key`, create: func() error { _, err := NewCMAC(make([]byte, 15)); return err }, expectedError: ErrAESKeySize},
+	{name: `CMAC with 33 byte key`, create: func() error { _, err := NewCMAC(make([]byte, 33)); return err }, expectedError: ErrAESKeySize},
+	{name: `GMAC with 20 byte key`, create: func() error { _, err := NewGMAC(make([]byte, 20), make([]byte, 12)); return err }, expectedError: ErrAESKeySize},
+	{name: `GMAC with empty nonce`, create: func() error { _, err := NewGMAC(make([]byte, 16), nil); return err }, expectedError: ErrNonceEmpty},
+	{name: `Poly1305 with 31 byte key`, create: func() error { _, err := NewPoly1305(make([]byte, Poly1305KeySize-1)); return err }, expectedError: ErrPoly1305KeySize},
+	{name: `Poly1305 with 33 byte key`, create: func() error { _, err := NewPoly1305(make([]byte, Poly1305KeySize+1)); return err }, expectedError: ErrPoly1305KeySize},
+}
+
+// ******** Test functions ********
+
+// TestCMAC tests AES-CMAC with the NIST SP 800-38B examples.
+func TestCMAC(t *testing.T) {
+	message := mustDecodeHex(t, cmacMessage)
+
+	for _, v := range cmacVectors {
+		m, err := NewCMAC(mustDecodeHex(t, v.key))
+		if err != nil {
+			t.Fatalf(`Unexpected error: %v`, err)
+		}
+
+		checkMAC(t, `CMAC with key `+v.key, m, message[:v.messageLength], v.expected)
+	}
+}
+
+// TestGMAC tests GMAC with GCM test vectors that have an empty plaintext.
+func TestGMAC(t *testing.T) {
+	for _, v := range gmacVectors {
+		m, err := NewGMAC(mustDecodeHex(t, v.key), mustDecodeHex(t, v.nonce))
+		if err != nil {
+			t.Fatalf(`Unexpected error: %v`, err)
+		}
+
+		checkMAC(t, `GMAC with key `+v.key, m, mustDecodeHex(t, v.message), v.expected)
+	}
+}
+
+// TestPoly1305 tests Poly1305 with the test vector from RFC 8439, section 2.5.2.
+func TestPoly1305(t *testing.T) {
+	m, err := NewPoly1305(mustDecodeHex(t, `85d6be7857556d337f4452fe42d506a80103808afb0db2fd4abff6af4149f51b`))
+	if err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	checkMAC(t, `Poly1305`, m, []byte(`Cryptographic Forum Research Group`), `a8061dc1305136c6c22b8baf0c0127a9`)
+}
+
+// TestKeyErrors tests that keys and nonces with invalid lengths are rejected.
+func TestKeyErrors(t *testing.T) {
+	for _, e := range keyErrors {
+		err := e.create()
+		if !errors.Is(err, e.expectedError) {
+			t.Errorf(`%s: got error %v, expected %v`, e.name, err, e.expectedError)
+		}
+	}
+}
+
+// ******** Private functions ********
+
+// checkMAC checks a message authentication code with the message written at once and byte by byte.
+func checkMAC(t *testing.T, name string, m hash.Hash, message []byte, expected string) {
+	t.Helper()
+
+	_, _ = m.Write(message)
+	result := hex.EncodeToString(m.Sum(nil))
+	if result != expected {
+		t.Errorf(`%s of %d bytes: got %s, expected %s`, name, len(message), result, expected)
+	}
+
+	m.Reset()
+	for i := range message {
+		_, _ = m.Write(message[i : i+1])
+	}
+
+	result = hex.EncodeToString(m.Sum(nil))
+	if result != expected {
+		t.Errorf(`%s of %d bytes byte by byte: got %s, expected %s`, name, len(message), result, expected)
+	}
+}
+
+// mustDecodeHex decodes a hex string and fails the test if that is not possible.
+func mustDecodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+
+	result, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatalf(`Invalid hex string "%s": %v`, s, err)
+	}
+
+	return result
+}
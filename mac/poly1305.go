@@ -0,0 +1,209 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package mac
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// ******** Public constants ********
+
+// Poly1305KeySize is the size of a Poly1305 key in bytes.
+const Poly1305KeySize = 32
+
+// ******** Private constants ********
+
+// These are the masks that clamp the r part of the key.
+const (
+	rMask0 = 0x0fff_fffc_0fff_ffff
+	rMask1 = 0x0fff_fffc_0fff_fffc
+)
+
+// These are the words of the prime p = 2^130 - 5.
+const (
+	p0 = 0xffff_ffff_ffff_fffb
+	p1 = 0xffff_ffff_ffff_ffff
+	p2 = 0x3
+)
+
+// ******** Public types ********
+
+// Poly1305 implements the Poly1305 one-time authenticator.
+// A key must never be used for more than one message.
+type Poly1305 struct {
+	r0, r1       uint64
+	s0, s1       uint64
+	h0, h1, h2   uint64
+	buffer       [BlockSize]byte
+	bufferedSize int
+}
+
+// ******** Public functions ********
+
+// NewPoly1305 creates a new Poly1305 function with the given key.
+// The key must be Poly1305KeySize bytes long.
+func NewPoly1305(key []byte) (*Poly1305, error) {
+	if len(key) != Poly1305KeySize {
+		return nil, ErrPoly1305KeySize
+	}
+
+	return &Poly1305{
+		r0: binary.LittleEndian.Uint64(key[0:8]) & rMask0,
+		r1: binary.LittleEndian.Uint64(key[8:16]) & rMask1,
+		s0: binary.LittleEndian.Uint64(key[16:24]),
+		s1: binary.LittleEndian.Uint64(key[24:32]),
+	}, nil
+}
+
+// -------- Poly1305 methods --------
+
+// Write adds more data to the running message authentication code.
+func (m *Poly1305) Write(p []byte) (int, error) {
+	n := len(p)
+
+	if m.bufferedSize != 0 {
+		copied := copy(m.buffer[m.bufferedSize:], p)
+		m.bufferedSize += copied
+		p = p[copied:]
+
+		if m.bufferedSize < BlockSize {
+			return n, nil
+		}
+
+		m.h0, m.h1, m.h2 = m.block(m.h0, m.h1, m.h2, m.buffer[:], 1)
+		m.bufferedSize = 0
+	}
+
+	for len(p) >= BlockSize {
+		m.h0, m.h1, m.h2 = m.block(m.h0, m.h1, m.h2, p, 1)
+		p = p[BlockSize:]
+	}
+
+	m.bufferedSize = copy(m.buffer[:], p)
+
+	return n, nil
+}
+
+// Sum appends the current message authentication code to b and returns the resulting slice.
+// It does not change the underlying state.
+func (m *Poly1305) Sum(b []byte) []byte {
+	h0, h1, h2 := m.h0, m.h1, m.h2
+
+	// A partial last block is padded with a 1 byte and zeros, instead of setting bit 128.
+	if m.bufferedSize != 0 {
+		var last [BlockSize]byte
+		copy(last[:], m.buffer[:m.bufferedSize])
+		last[m.bufferedSize] = 1
+		h0, h1, h2 = m.block(h0, h1, h2, last[:], 0)
+	}
+
+	// h is fully reduced by subtracting p, if h is not smaller than p.
+	t0, borrow := bits.Sub64(h0, p0, 0)
+	t1, borrow := bits.Sub64(h1, p1, borrow)
+	_, borrow = bits.Sub64(h2, p2, borrow)
+
+	// The selection is done in constant time. The mask is all ones, if h < p.
+	mask := -borrow
+	h0 = h0&mask | t0&^mask
+	h1 = h1&mask | t1&^mask
+
+	// The tag is (h + s) mod 2^128.
+	var carry uint64
+	h0, carry = bits.Add64(h0, m.s0, 0)
+	h1, _ = bits.Add64(h1, m.s1, carry)
+
+	b = binary.LittleEndian.AppendUint64(b, h0)
+
+	return binary.LittleEndian.AppendUint64(b, h1)
+}
+
+// Reset resets the message authentication code to its initial state.
+func (m *Poly1305) Reset() {
+	m.h0, m.h1, m.h2 = 0, 0, 0
+	clear(m.buffer[:])
+	m.bufferedSize = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (m *Poly1305) Size() int {
+	return Size
+}
+
+// BlockSize returns the block size of the message authentication code.
+func (m *Poly1305) BlockSize() int {
+	return BlockSize
+}
+
+// ******** Private functions ********
+
+// block adds a 16 byte block with the bit 128 set to highBit to the accumulator h
+// and multiplies h by r modulo p.
+func (m *Poly1305) block(h0, h1, h2 uint64, block []byte, highBit uint64) (uint64, uint64, uint64) {
+	// 1. h += block.
+	var carry uint64
+	h0, carry = bits.Add64(h0, binary.LittleEndian.Uint64(block[0:8]), 0)
+	h1, carry = bits.Add64(h1, binary.LittleEndian.Uint64(block[8:16]), carry)
+	h2 += carry + highBit
+
+	// 2. h *= r. As r is clamped and h2 is small, the products with h2 fit into 64 bits.
+	h0r0Hi, h0r0Lo := bits.Mul64(h0, m.r0)
+	h1r0Hi, h1r0Lo := bits.Mul64(h1, m.r0)
+	h0r1Hi, h0r1Lo := bits.Mul64(h0, m.r1)
+	h1r1Hi, h1r1Lo := bits.Mul64(h1, m.r1)
+	h2r0 := h2 * m.r0
+	h2r1 := h2 * m.r1
+
+	m1Lo, c := bits.Add64(h1r0Lo, h0r1Lo, 0)
+	m1Hi, _ := bits.Add64(h1r0Hi, h0r1Hi, c)
+	m2Lo, c := bits.Add64(h2r0, h1r1Lo, 0)
+	m2Hi, _ := bits.Add64(0, h1r1Hi, c)
+
+	t0 := h0r0Lo
+	t1, c := bits.Add64(m1Lo, h0r0Hi, 0)
+	t2, c := bits.Add64(m2Lo, m1Hi, c)
+	t3, _ := bits.Add64(h2r1+m2Hi, 0, c)
+
+	// 3. Partial reduction modulo p, using 2^130 = 5 mod p.
+	// The bits above 2^130 are c = t >> 130, which are added as 4*c + c.
+	h0, h1, h2 = t0, t1, t2&3
+	cLo, cHi := t2&^3, t3
+
+	h0, carry = bits.Add64(h0, cLo, 0)
+	h1, carry = bits.Add64(h1, cHi, carry)
+	h2 += carry
+
+	cLo, cHi = cLo>>2|cHi<<62, cHi>>2
+
+	h0, carry = bits.Add64(h0, cLo, 0)
+	h1, carry = bits.Add64(h1, cHi, carry)
+	h2 += carry
+
+	return h0, h1, h2
+}
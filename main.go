@@ -1,5 +1,5 @@
 //
-// SPDX-FileCopyrightText: Copyright 2024-2025 Frank Schwab
+// SPDX-FileCopyrightText: Copyright 2024-2026 Frank Schwab
 //
 // SPDX-License-Identifier: Apache-2.0
 //
@@ -20,7 +20,7 @@
 //
 // Author: Frank Schwab
 //
-// Version: 4.0.0
+// Version: 4.25.0
 //
 // Change history:
 //    2024-12-20: V1.0.0: Created.
@@ -35,14 +35,41 @@
 //    2025-02-26: V2.0.0: Just print the value in one encoding. No headers. No multiple encodings.
 //    2025-03-02: V3.0.0: New command line structure. Ability to specify hex bytes.
 //    2025-04-17: V4.0.0: No default hash algorithm.
+//    2026-10-16: V4.1.0: Add HMAC calculation.
+//    2026-10-16: V4.2.0: Use keyed mode of Blake2x hashes. Add "blake2s-128" which needs a key.
+//    2026-10-16: V4.3.0: Add extendable-output functions "shake128" and "shake256".
+//    2026-10-16: V4.4.0: Add cSHAKE and KMAC functions.
+//    2026-10-16: V4.5.0: Add TupleHash and ParallelHash functions.
+//    2026-10-16: V4.6.0: Add BLAKE3.
+//    2026-10-16: V4.7.0: Add non-cryptographic checksums and decimal encoding.
+//    2026-10-16: V4.8.0: Add named CRCs and custom CRCs.
+//    2026-10-16: V4.9.0: Add xxHash functions.
+//    2026-10-16: V4.10.0: Add MurmurHash3 and SipHash functions.
+//    2026-10-16: V4.11.0: Add legacy hash functions and warn when they are used.
+//    2026-10-16: V4.12.0: Add Keccak functions with the original padding.
+//    2026-10-16: V4.13.0: Add SM3 and Streebog.
+//    2026-10-16: V4.14.0: Add KangarooTwelve and TurboSHAKE.
+//    2026-10-16: V4.15.0: Add Ascon hash functions.
+//    2026-10-16: V4.16.0: Add Skein and Grøstl.
+//    2026-10-16: V4.17.0: Add BLAKE2 with any digest size, salt and personalization.
+//    2026-10-16: V4.18.0: Add AES-CMAC, AES-GMAC and Poly1305.
+//    2026-10-16: V4.19.0: Add list of algorithms.
+//    2026-10-16: V4.20.0: Accept aliases and suggest similar names for unknown algorithms.
+//    2026-10-16: V4.21.0: Add password hashing.
+//    2026-10-16: V4.22.0: Add password verification.
+//    2026-10-16: V4.23.0: Add SHA-crypt, MD5-crypt and APR1-MD5 password hashes.
+//    2026-10-16: V4.24.0: Add HKDF key derivation.
+//    2026-10-16: V4.25.0: Add Hash_DRBG and HMAC_DRBG.
 //
 
 package main
 
 import (
+	"hash"
 	"hashvalue/filehelper"
 	"hashvalue/hashfactory"
 	"os"
+	"strings"
 )
 
 // main is entry point of the program.
@@ -54,10 +81,10 @@ func main() {
 // ******** Private constants ********
 
 // myVersion contains the current version of this program.
-const myVersion = `4.0.0`
+const myVersion = `4.25.0`
 
 // myCopyright contains the copyright of this program.
-const myCopyright = `Copyright (c) 2024-2025 Frank Schwab`
+const myCopyright = `Copyright (c) 2024-2026 Frank Schwab`
 
 // ******** Private variables ********
 
@@ -79,6 +106,12 @@ func realMain() int {
 		return rcOK
 	}
 
+	// List algorithms and exit if a list is requested.
+	if listAlgorithms {
+		listFormat = strings.ToLower(strings.TrimSpace(listFormat))
+		return printAlgorithmList()
+	}
+
 	// 2. Normalize command line flags.
 	normalizeCommandLineFlags()
 
@@ -88,20 +121,109 @@ func realMain() int {
 		return rc
 	}
 
+	// Password hashes are calculated and verified differently from all other hash values.
+	if len(passwordHashAlgorithm) != 0 {
+		return hashPassword(encodedPrinter)
+	}
+
+	if len(verifyPassword) != 0 {
+		return verifyPasswordHash()
+	}
+
+	// HKDF derives a key from the input keying material instead of hashing a source.
+	if len(hkdfAlgorithm) != 0 {
+		return deriveHKDF(encodedPrinter)
+	}
+
+	// DRBGs generate bytes from the entropy input instead of hashing a source.
+	if isDRBG() {
+		return generateDRBGBytes(encodedPrinter)
+	}
+
 	// 4. Get hash function.
-	hashFunc, ok := hashfactory.New(hashAlgorithm)
-	if !ok {
-		return printUsageErrorf(`Invalid hash algorithm: '%s'`, hashAlgorithm)
+	hashFunc, rc := newHashFunction()
+	if rc != rcOK {
+		return rc
+	}
+
+	// Warn, if a legacy hash algorithm is used.
+	if hashfactory.IsLegacy(hashAlgorithm) {
+		printWarningf(`'%s' is a legacy hash algorithm. It should only be used for compatibility with existing data.`, hashAlgorithm)
+	}
+
+	// 5. Check number of sources for the hash function.
+	rc = checkSourceCount(hashFunc)
+	if rc != rcOK {
+		return rc
 	}
 
-	// 3. Hash data.
-	hashValue, err := hashData(hashFunc, sourceBytes, fileName)
+	// 6. Hash data.
+	hashValue, err := hashData(hashFunc, sourceParts)
 	if err != nil {
 		return printErrorf(`Error hashing data: %s`, err)
 	}
 
-	// 4. Print result.
+	// 7. Print result.
 	encodedPrinter.PrintEncoded(hashValue)
 
 	return rcOK
 }
+
+// newHashFunction creates the hash function with the parameters from the command line.
+func newHashFunction() (hash.Hash, int) {
+	parameters := &hashfactory.Parameters{
+		Key:             keyBytes,
+		OutputLength:    outputLength,
+		Customization:   []byte(customization),
+		FunctionName:    []byte(functionName),
+		BlockSize:       blockSize,
+		Context:         []byte(context),
+		Seed:            seed,
+		Salt:            saltBytes,
+		Personalization: personalizationBytes,
+		Nonce:           nonceBytes,
+	}
+
+	if len(crcParameters) != 0 {
+		hashFunc, err := hashfactory.NewCustomCRC(crcParameters, parameters)
+		if err != nil {
+			return nil, printUsageErrorf(`Invalid CRC parameters '%s': %v`, crcParameters, err)
+		}
+
+		return hashFunc, rcOK
+	}
+
+	canonicalName, isKnown := hashfactory.CanonicalName(hashAlgorithm)
+	if !isKnown {
+		return nil, printUsageErrorf(`Invalid hash algorithm: '%s'%s`, hashAlgorithm, didYouMean(hashfactory.Suggestions(hashAlgorithm)))
+	}
+
+	// Aliases are replaced by the name of the algorithm, so that all messages use that name.
+	hashAlgorithm = canonicalName
+
+	hashFunc, err := hashfactory.NewWithParameters(hashAlgorithm, parameters)
+	if err != nil {
+		return nil, printUsageErrorf(`Invalid parameters for hash algorithm '%s': %v`, hashAlgorithm, err)
+	}
+
+	return hashFunc, rcOK
+}
+
+// didYouMean returns the text that suggests similar names. It is empty, if there are no suggestions.
+func didYouMean(suggestions []string) string {
+	if len(suggestions) == 0 {
+		return ``
+	}
+
+	quoted := make([]string, len(suggestions))
+	for i, suggestion := range suggestions {
+		quoted[i] = `'` + suggestion + `'`
+	}
+
+	last := len(quoted) - 1
+	if last == 0 {
+		return `. Did you mean ` + quoted[0] + `?`
+	}
+
+	return `. Did you mean ` + strings.Join(quoted[:last], `, `) + ` or ` + quoted[last] + `?`
+}
@@ -0,0 +1,341 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+// Package murmur3 implements the non-cryptographic MurmurHash3 hash functions
+// x86_32 and x64_128 by Austin Appleby (https://github.com/aappleby/smhasher).
+//
+// The hash values are returned in big-endian byte order.
+// The 128 bit hash value consists of the first 64 bit value followed by the second 64 bit value.
+package murmur3
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// ******** Public constants ********
+
+// Size32 is the size of a MurmurHash3 x86_32 hash value in bytes.
+const Size32 = 4
+
+// Size128 is the size of a MurmurHash3 x64_128 hash value in bytes.
+const Size128 = 16
+
+// ******** Private constants ********
+
+// Block sizes.
+const (
+	blockSize32  = 4
+	blockSize128 = 16
+)
+
+// Constants of the x86_32 variant.
+const (
+	c1_32 uint32 = 0xcc9e2d51
+	c2_32 uint32 = 0x1b873593
+)
+
+// Constants of the x64_128 variant.
+const (
+	c1_128 uint64 = 0x87c37b91114253d5
+	c2_128 uint64 = 0x4cf5ad432745937f
+)
+
+// ******** Public types ********
+
+// Digest32 implements the MurmurHash3 x86_32 hash function.
+type Digest32 struct {
+	seed         uint32
+	h            uint32
+	buffer       [blockSize32]byte
+	bufferedSize int
+	totalLength  uint64
+}
+
+// Digest128 implements the MurmurHash3 x64_128 hash function.
+type Digest128 struct {
+	seed         uint32
+	h1           uint64
+	h2           uint64
+	buffer       [blockSize128]byte
+	bufferedSize int
+	totalLength  uint64
+}
+
+// ******** Public functions ********
+
+// New32 creates a new MurmurHash3 x86_32 hash function with the given seed.
+func New32(seed uint32) *Digest32 {
+	d := &Digest32{seed: seed}
+	d.Reset()
+
+	return d
+}
+
+// New128 creates a new MurmurHash3 x64_128 hash function with the given seed.
+func New128(seed uint32) *Digest128 {
+	d := &Digest128{seed: seed}
+	d.Reset()
+
+	return d
+}
+
+// -------- Digest32 methods --------
+
+// Write adds more data to the running hash.
+func (d *Digest32) Write(p []byte) (int, error) {
+	n := len(p)
+	d.totalLength += uint64(n)
+
+	if d.bufferedSize != 0 {
+		copied := copy(d.buffer[d.bufferedSize:], p)
+		d.bufferedSize += copied
+		p = p[copied:]
+
+		if d.bufferedSize < blockSize32 {
+			return n, nil
+		}
+
+		d.block(d.buffer[:])
+		d.bufferedSize = 0
+	}
+
+	for len(p) >= blockSize32 {
+		d.block(p)
+		p = p[blockSize32:]
+	}
+
+	d.bufferedSize = copy(d.buffer[:], p)
+
+	return n, nil
+}
+
+// Sum32 returns the current hash value.
+func (d *Digest32) Sum32() uint32 {
+	h := d.h
+
+	var k uint32
+	tail := d.buffer[:d.bufferedSize]
+	for i := len(tail) - 1; i >= 0; i-- {
+		k = k<<8 | uint32(tail[i])
+	}
+
+	if len(tail) != 0 {
+		h ^= mixK32(k)
+	}
+
+	h ^= uint32(d.totalLength)
+
+	return fmix32(h)
+}
+
+// Sum appends the current hash value in big-endian byte order to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (d *Digest32) Sum(b []byte) []byte {
+	return binary.BigEndian.AppendUint32(b, d.Sum32())
+}
+
+// Reset resets the hash to its initial state.
+func (d *Digest32) Reset() {
+	d.h = d.seed
+	d.bufferedSize = 0
+	d.totalLength = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (d *Digest32) Size() int {
+	return Size32
+}
+
+// BlockSize returns the hash's underlying block size.
+func (d *Digest32) BlockSize() int {
+	return blockSize32
+}
+
+// block processes one block.
+func (d *Digest32) block(p []byte) {
+	h := d.h ^ mixK32(binary.LittleEndian.Uint32(p))
+	h = bits.RotateLeft32(h, 13)
+	d.h = h*5 + 0xe6546b64
+}
+
+// -------- Digest128 methods --------
+
+// Write adds more data to the running hash.
+func (d *Digest128) Write(p []byte) (int, error) {
+	n := len(p)
+	d.totalLength += uint64(n)
+
+	if d.bufferedSize != 0 {
+		copied := copy(d.buffer[d.bufferedSize:], p)
+		d.bufferedSize += copied
+		p = p[copied:]
+
+		if d.bufferedSize < blockSize128 {
+			return n, nil
+		}
+
+		d.block(d.buffer[:])
+		d.bufferedSize = 0
+	}
+
+	for len(p) >= blockSize128 {
+		d.block(p)
+		p = p[blockSize128:]
+	}
+
+	d.bufferedSize = copy(d.buffer[:], p)
+
+	return n, nil
+}
+
+// Sum128 returns the current hash value as two 64 bit values.
+func (d *Digest128) Sum128() (uint64, uint64) {
+	h1, h2 := d.h1, d.h2
+
+	var k1, k2 uint64
+	tail := d.buffer[:d.bufferedSize]
+	for i := len(tail) - 1; i >= 0; i-- {
+		if i >= 8 {
+			k2 = k2<<8 | uint64(tail[i])
+		} else {
+			k1 = k1<<8 | uint64(tail[i])
+		}
+	}
+
+	if len(tail) > 8 {
+		h2 ^= mixK2(k2)
+	}
+
+	if len(tail) != 0 {
+		h1 ^= mixK1(k1)
+	}
+
+	h1 ^= d.totalLength
+	h2 ^= d.totalLength
+
+	h1 += h2
+	h2 += h1
+
+	h1 = fmix64(h1)
+	h2 = fmix64(h2)
+
+	h1 += h2
+	h2 += h1
+
+	return h1, h2
+}
+
+// Sum appends the current hash value in big-endian byte order to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (d *Digest128) Sum(b []byte) []byte {
+	h1, h2 := d.Sum128()
+	b = binary.BigEndian.AppendUint64(b, h1)
+
+	return binary.BigEndian.AppendUint64(b, h2)
+}
+
+// Reset resets the hash to its initial state.
+func (d *Digest128) Reset() {
+	d.h1 = uint64(d.seed)
+	d.h2 = uint64(d.seed)
+	d.bufferedSize = 0
+	d.totalLength = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (d *Digest128) Size() int {
+	return Size128
+}
+
+// BlockSize returns the hash's underlying block size.
+func (d *Digest128) BlockSize() int {
+	return blockSize128
+}
+
+// block processes one block.
+func (d *Digest128) block(p []byte) {
+	h1 := d.h1 ^ mixK1(binary.LittleEndian.Uint64(p))
+	h1 = bits.RotateLeft64(h1, 27) + d.h2
+	h1 = h1*5 + 0x52dce729
+
+	h2 := d.h2 ^ mixK2(binary.LittleEndian.Uint64(p[8:]))
+	h2 = bits.RotateLeft64(h2, 31) + h1
+	h2 = h2*5 + 0x38495ab5
+
+	d.h1 = h1
+	d.h2 = h2
+}
+
+// ******** Private functions ********
+
+// mixK32 mixes a block value of the x86_32 variant.
+func mixK32(k uint32) uint32 {
+	k *= c1_32
+	k = bits.RotateLeft32(k, 15)
+
+	return k * c2_32
+}
+
+// mixK1 mixes the first block value of the x64_128 variant.
+func mixK1(k uint64) uint64 {
+	k *= c1_128
+	k = bits.RotateLeft64(k, 31)
+
+	return k * c2_128
+}
+
+// mixK2 mixes the second block value of the x64_128 variant.
+func mixK2(k uint64) uint64 {
+	k *= c2_128
+	k = bits.RotateLeft64(k, 33)
+
+	return k * c1_128
+}
+
+// fmix32 is the final mix of the x86_32 variant.
+func fmix32(h uint32) uint32 {
+	h ^= h >> 16
+	h *= 0x85ebca6b
+	h ^= h >> 13
+	h *= 0xc2b2ae35
+	h ^= h >> 16
+
+	return h
+}
+
+// fmix64 is the final mix of the x64_128 variant.
+func fmix64(k uint64) uint64 {
+	k ^= k >> 33
+	k *= 0xff51afd7ed558ccd
+	k ^= k >> 33
+	k *= 0xc4ceb9fe1a85ec53
+	k ^= k >> 33
+
+	return k
+}
@@ -0,0 +1,163 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.2.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//    2026-10-16: V1.1.0: Parse encoded password hashes.
+//    2026-10-16: V1.2.0: Limit the memory size.
+//
+
+package password
+
+import (
+	"fmt"
+	"golang.org/x/crypto/argon2"
+	"math"
+)
+
+// ******** Private constants ********
+
+// These are the default Argon2 parameters. They are the second recommended option of RFC 9106.
+const (
+	argon2DefaultIterations  = 3
+	argon2DefaultMemory      = 64 * 1024
+	argon2DefaultParallelism = 4
+)
+
+// argon2MinSaltSize is the minimum salt size in bytes from RFC 9106.
+const argon2MinSaltSize = 8
+
+// argon2MinKeyLength is the minimum key length in bytes from RFC 9106.
+const argon2MinKeyLength = 4
+
+// argon2MaxParallelism is the maximum degree of parallelism supported by the implementation.
+const argon2MaxParallelism = math.MaxUint8
+
+// argon2MaxMemory is the maximum memory size in KiB.
+const argon2MaxMemory = maxMemorySize >> 10
+
+// ******** Private functions ********
+
+// init registers the Argon2 functions.
+func init() {
+	register(`argon2id`, newArgon2Deriver(`argon2id`, argon2.IDKey), usesIterations|usesMemory|usesParallelism|usesKeyLength)
+	register(`argon2i`, newArgon2Deriver(`argon2i`, argon2.Key), usesIterations|usesMemory|usesParallelism|usesKeyLength)
+
+	registerParser(`argon2id`, newArgon2Parser(`argon2id`))
+	registerParser(`argon2i`, newArgon2Parser(`argon2i`))
+}
+
+// newArgon2Deriver returns a derivation function for an Argon2 variant.
+func newArgon2Deriver(
+	id string,
+	key func(password, salt []byte, time, memory uint32, threads uint8, keyLen uint32) []byte,
+) func(password []byte, p *Parameters) (*Result, error) {
+	return func(password []byte, p *Parameters) (*Result, error) {
+		iterations := valueOrDefault(p.Iterations, argon2DefaultIterations)
+		memory := valueOrDefault(p.Memory, argon2DefaultMemory)
+		parallelism := valueOrDefault(p.Parallelism, argon2DefaultParallelism)
+		keyLength := valueOrDefault(p.KeyLength, defaultKeyLength)
+
+		err := checkArgon2Parameters(p.Salt, iterations, memory, parallelism, keyLength)
+		if err != nil {
+			return nil, err
+		}
+
+		derivedKey := key(password, p.Salt, uint32(iterations), uint32(memory), uint8(parallelism), uint32(keyLength))
+
+		return &Result{
+			Key:  derivedKey,
+			Salt: p.Salt,
+			Encoded: fmt.Sprintf(`$%s$v=%d$m=%d,t=%d,p=%d$%s$%s`,
+				id,
+				argon2.Version,
+				memory,
+				iterations,
+				parallelism,
+				b64.EncodeToString(p.Salt),
+				b64.EncodeToString(derivedKey),
+			),
+		}, nil
+	}
+}
+
+// newArgon2Parser returns a parser for an encoded Argon2 password hash like "$argon2id$v=19$m=65536,t=3,p=4$salt$hash".
+func newArgon2Parser(name string) parser {
+	return func(fields []string) (string, *Parameters, []byte, error) {
+		if len(fields) != 5 {
+			return ``, nil, nil, ErrInvalidFormat
+		}
+
+		if fields[1] != fmt.Sprintf(`v=%d`, argon2.Version) {
+			return ``, nil, nil, fmt.Errorf(`%w: only Argon2 version %d is supported`, ErrUnsupportedFormat, argon2.Version)
+		}
+
+		values, err := parseParameterList(fields[2], `m`, `t`, `p`)
+		if err != nil {
+			return ``, nil, nil, err
+		}
+
+		salt, key, err := decodeSaltAndKey(fields[3], fields[4])
+		if err != nil {
+			return ``, nil, nil, err
+		}
+
+		return name,
+			&Parameters{
+				Salt:        salt,
+				Memory:      values[0],
+				Iterations:  values[1],
+				Parallelism: values[2],
+				KeyLength:   len(key),
+			},
+			key,
+			nil
+	}
+}
+
+// checkArgon2Parameters checks the Argon2 parameters.
+func checkArgon2Parameters(salt []byte, iterations int, memory int, parallelism int, keyLength int) error {
+	if len(salt) < argon2MinSaltSize {
+		return fmt.Errorf(`salt must be at least %d bytes long`, argon2MinSaltSize)
+	}
+
+	if iterations > math.MaxUint32 {
+		return fmt.Errorf(`number of iterations must not be greater than %d`, uint32(math.MaxUint32))
+	}
+
+	if parallelism > argon2MaxParallelism {
+		return fmt.Errorf(`parallelism must not be greater than %d`, argon2MaxParallelism)
+	}
+
+	// The memory must contain at least 8 blocks of 1 KiB per lane.
+	if memory < 8*parallelism || memory > argon2MaxMemory {
+		return fmt.Errorf(`memory size must be between %d and %d KiB`, 8*parallelism, argon2MaxMemory)
+	}
+
+	if keyLength < argon2MinKeyLength {
+		return fmt.Errorf(`key length must be at least %d bytes`, argon2MinKeyLength)
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.2.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//    2026-10-16: V1.1.0: Parse encoded password hashes.
+//    2026-10-16: V1.2.0: Truncate long passwords when they are verified.
+//
+
+package password
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/blowfish"
+)
+
+// ******** Private constants ********
+
+// bcryptDefaultCost is the default bcrypt cost. It is the default cost of golang.org/x/crypto/bcrypt.
+const bcryptDefaultCost = 10
+
+// These are the limits of the bcrypt cost.
+const (
+	bcryptMinCost = 4
+	bcryptMaxCost = 31
+)
+
+// bcryptSaltSize is the size of a bcrypt salt in bytes.
+const bcryptSaltSize = 16
+
+// bcryptMaxPasswordLength is the maximum length of a bcrypt password in bytes.
+const bcryptMaxPasswordLength = 72
+
+// bcryptKeyLength is the length of a bcrypt hash in bytes.
+// Only 23 of the 24 encrypted bytes are used for compatibility with the original implementation.
+const bcryptKeyLength = 23
+
+// bcryptVersion is the version identifier of the modular crypt format.
+const bcryptVersion = `2b`
+
+// bcryptEncodedSaltLength is the length of the encoded bcrypt salt.
+const bcryptEncodedSaltLength = 22
+
+// ******** Private variables ********
+
+// bcryptMagic is the text that is encrypted by bcrypt.
+var bcryptMagic = []byte(`OrpheanBeholderScryDoubt`)
+
+// bcryptB64 is the base64 encoding of bcrypt with its own alphabet and without padding.
+var bcryptB64 = base64.NewEncoding(`./ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789`).WithPadding(base64.NoPadding)
+
+// errBcryptPasswordTooLong is returned when a password is too long for bcrypt.
+var errBcryptPasswordTooLong = errors.New(`password must not be longer than 72 bytes for bcrypt`)
+
+// ******** Private functions ********
+
+// init registers the bcrypt function.
+func init() {
+	register(`bcrypt`, deriveBcrypt, usesCost)
+
+	// Longer passwords are rejected when they are hashed, but truncated when they are verified,
+	// like golang.org/x/crypto/bcrypt does.
+	nameToAlgorithm[`bcrypt`].checkPassword = checkBcryptPassword
+
+	// The versions "2a" and "2y" only differ from "2b" for passwords that are longer than 72 bytes.
+	for _, version := range []string{`2a`, bcryptVersion, `2y`} {
+		registerParser(version, parseBcrypt)
+	}
+}
+
+// deriveBcrypt derives a password hash with bcrypt.
+func deriveBcrypt(password []byte, p *Parameters) (*Result, error) {
+	cost := valueOrDefault(p.Cost, bcryptDefaultCost)
+
+	if cost < bcryptMinCost || cost > bcryptMaxCost {
+		return nil, fmt.Errorf(`cost must be between %d and %d`, bcryptMinCost, bcryptMaxCost)
+	}
+
+	if len(p.Salt) != bcryptSaltSize {
+		return nil, fmt.Errorf(`salt must be %d bytes long for bcrypt`, bcryptSaltSize)
+	}
+
+	derivedKey, err := bcryptKey(password, p.Salt, cost)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		Key:     derivedKey,
+		Salt:    p.Salt,
+		Encoded: fmt.Sprintf(`$%s$%02d$%s%s`, bcryptVersion, cost, bcryptB64.EncodeToString(p.Salt), bcryptB64.EncodeToString(derivedKey)),
+	}, nil
+}
+
+// checkBcryptPassword checks whether the password is not too long for bcrypt.
+func checkBcryptPassword(password []byte) error {
+	if len(password) > bcryptMaxPasswordLength {
+		return errBcryptPasswordTooLong
+	}
+
+	return nil
+}
+
+// parseBcrypt parses an encoded bcrypt password hash like "$2b$10$saltandhash".
+func parseBcrypt(fields []string) (string, *Parameters, []byte, error) {
+	if len(fields) != 3 || len(fields[1]) != 2 || len(fields[2]) <= bcryptEncodedSaltLength {
+		return ``, nil, nil, ErrInvalidFormat
+	}
+
+	cost, err := parsePositive(fields[1])
+	if err != nil {
+		return ``, nil, nil, err
+	}
+
+	salt, err := bcryptB64.DecodeString(fields[2][:bcryptEncodedSaltLength])
+	if err != nil {
+		return ``, nil, nil, fmt.Errorf(`%w: invalid salt: %w`, ErrInvalidFormat, err)
+	}
+
+	key, err := bcryptB64.DecodeString(fields[2][bcryptEncodedSaltLength:])
+	if err != nil || len(key) != bcryptKeyLength {
+		return ``, nil, nil, fmt.Errorf(`%w: invalid hash`, ErrInvalidFormat)
+	}
+
+	return `bcrypt`, &Parameters{Salt: salt, Cost: cost}, key, nil
+}
+
+// bcryptKey calculates the bcrypt hash with the expensive key schedule "EksBlowfishSetup".
+// Only the first 72 bytes of the password are used.
+func bcryptKey(password []byte, salt []byte, cost int) ([]byte, error) {
+	password = password[:min(len(password), bcryptMaxPasswordLength)]
+
+	// The terminating zero byte of the C string is part of the key.
+	key := append(password[:len(password):len(password)], 0)
+
+	c, err := blowfish.NewSaltedCipher(key, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	rounds := uint64(1) << cost
+	for range rounds {
+		blowfish.ExpandKey(key, c)
+		blowfish.ExpandKey(salt, c)
+	}
+
+	data := make([]byte, len(bcryptMagic))
+	copy(data, bcryptMagic)
+
+	for i := 0; i < len(data); i += blowfish.BlockSize {
+		for range 64 {
+			c.Encrypt(data[i:i+blowfish.BlockSize], data[i:i+blowfish.BlockSize])
+		}
+	}
+
+	return data[:bcryptKeyLength], nil
+}
@@ -0,0 +1,136 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.1
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//    2026-10-16: V1.0.1: Report invalid encoded digests as invalid format.
+//
+
+package password
+
+import (
+	"fmt"
+	"hashvalue/crypt"
+	"strings"
+)
+
+// ******** Private functions ********
+
+// init registers the crypt(3) schemes.
+func init() {
+	registerWithSalt(`sha256-crypt`, newSHACryptDeriver(crypt.SHA256), usesIterations, newCryptSaltGenerator(crypt.MaxSHASaltLength))
+	registerWithSalt(`sha512-crypt`, newSHACryptDeriver(crypt.SHA512), usesIterations, newCryptSaltGenerator(crypt.MaxSHASaltLength))
+	registerWithSalt(`md5-crypt`, newMD5CryptDeriver(crypt.MD5), 0, newCryptSaltGenerator(crypt.MaxMD5SaltLength))
+	registerWithSalt(`apr1`, newMD5CryptDeriver(crypt.APR1), 0, newCryptSaltGenerator(crypt.MaxMD5SaltLength))
+
+	registerParser(`5`, newSHACryptParser(`sha256-crypt`, crypt.DecodeSHA256))
+	registerParser(`6`, newSHACryptParser(`sha512-crypt`, crypt.DecodeSHA512))
+	registerParser(`1`, newMD5CryptParser(`md5-crypt`))
+	registerParser(`apr1`, newMD5CryptParser(`apr1`))
+}
+
+// newSHACryptDeriver returns a derivation function for a SHA-crypt scheme.
+// The number of iterations is the number of rounds.
+func newSHACryptDeriver(
+	shaCrypt func(password []byte, salt []byte, rounds int) (*crypt.Result, error),
+) func(password []byte, p *Parameters) (*Result, error) {
+	return func(password []byte, p *Parameters) (*Result, error) {
+		r, err := shaCrypt(password, p.Salt, p.Iterations)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Result{Key: r.Digest, Salt: r.Salt, Encoded: r.Encoded}, nil
+	}
+}
+
+// newMD5CryptDeriver returns a derivation function for a MD5-crypt scheme.
+func newMD5CryptDeriver(
+	md5Crypt func(password []byte, salt []byte) (*crypt.Result, error),
+) func(password []byte, p *Parameters) (*Result, error) {
+	return func(password []byte, p *Parameters) (*Result, error) {
+		r, err := md5Crypt(password, p.Salt)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Result{Key: r.Digest, Salt: r.Salt, Encoded: r.Encoded}, nil
+	}
+}
+
+// newCryptSaltGenerator returns a generator for salts of the given length from the crypt(3) alphabet.
+func newCryptSaltGenerator(length int) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		return crypt.NewSalt(length)
+	}
+}
+
+// newSHACryptParser returns a parser for an encoded SHA-crypt password hash like "$5$rounds=5000$salt$hash".
+// The number of rounds is optional.
+func newSHACryptParser(name string, decode func(text string) ([]byte, error)) parser {
+	return func(fields []string) (string, *Parameters, []byte, error) {
+		rounds := 0
+		if len(fields) == 4 {
+			roundsText, ok := strings.CutPrefix(fields[1], `rounds=`)
+			if !ok {
+				return ``, nil, nil, ErrInvalidFormat
+			}
+
+			var err error
+			rounds, err = parsePositive(roundsText)
+			if err != nil {
+				return ``, nil, nil, err
+			}
+
+			fields = fields[1:]
+		}
+
+		if len(fields) != 3 {
+			return ``, nil, nil, ErrInvalidFormat
+		}
+
+		key, err := decode(fields[2])
+		if err != nil {
+			return ``, nil, nil, fmt.Errorf(`%w: invalid hash: %w`, ErrInvalidFormat, err)
+		}
+
+		return name, &Parameters{Salt: []byte(fields[1]), Iterations: rounds}, key, nil
+	}
+}
+
+// newMD5CryptParser returns a parser for an encoded MD5-crypt password hash like "$1$salt$hash".
+func newMD5CryptParser(name string) parser {
+	return func(fields []string) (string, *Parameters, []byte, error) {
+		if len(fields) != 3 {
+			return ``, nil, nil, ErrInvalidFormat
+		}
+
+		key, err := crypt.DecodeMD5(fields[2])
+		if err != nil {
+			return ``, nil, nil, fmt.Errorf(`%w: invalid hash: %w`, ErrInvalidFormat, err)
+		}
+
+		return name, &Parameters{Salt: []byte(fields[1])}, key, nil
+	}
+}
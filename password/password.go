@@ -0,0 +1,267 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.4.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//    2026-10-16: V1.1.0: Parse encoded password hashes.
+//    2026-10-16: V1.2.0: Add salt generators for the crypt(3) schemes.
+//    2026-10-16: V1.3.0: Limit the memory size.
+//    2026-10-16: V1.4.0: Do not check passwords when they are verified.
+//
+
+// Package password implements password hashing with Argon2id, Argon2i, scrypt, bcrypt, PBKDF2
+// and the crypt(3) schemes SHA-256-crypt, SHA-512-crypt, MD5-crypt and APR1-MD5.
+//
+// A password hash is returned as the raw derived key and as a string in the PHC string format
+// (https://github.com/P-H-C/phc-string-format/blob/master/phc-sf-spec.md)
+// or, for bcrypt and the crypt(3) schemes, in the modular crypt format.
+// An encoded password hash can be verified against a password.
+package password
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ******** Public types ********
+
+// Parameters contains the parameters of a password hash.
+// A value of 0 selects the default value of the algorithm.
+type Parameters struct {
+	// Salt is the salt. A random salt is generated, if it is empty.
+	Salt []byte
+
+	// Iterations is the number of iterations of Argon2 and PBKDF2.
+	Iterations int
+
+	// Memory is the memory size of Argon2 in KiB.
+	Memory int
+
+	// Parallelism is the degree of parallelism of Argon2 and scrypt.
+	Parallelism int
+
+	// Cost is the binary logarithm of the number of rounds of bcrypt
+	// and of the CPU/memory cost parameter N of scrypt.
+	Cost int
+
+	// BlockFactor is the block size factor r of scrypt.
+	BlockFactor int
+
+	// KeyLength is the length of the derived key in bytes. bcrypt has a fixed key length.
+	KeyLength int
+}
+
+// Result is the result of a password hash.
+type Result struct {
+	// Key is the derived key.
+	Key []byte
+
+	// Salt is the salt that has been used.
+	Salt []byte
+
+	// Encoded is the password hash in the PHC string format or in the modular crypt format.
+	Encoded string
+}
+
+// ******** Public variables ********
+
+// ErrUnknownAlgorithm is returned when a password hash algorithm name is not known.
+var ErrUnknownAlgorithm = errors.New(`unknown password hash algorithm`)
+
+// ******** Private types ********
+
+// parameterUsage specifies which cost parameters an algorithm uses.
+type parameterUsage uint
+
+// These are the flags of the parameter usage.
+const (
+	usesIterations parameterUsage = 1 << iota
+	usesMemory
+	usesParallelism
+	usesCost
+	usesBlockFactor
+	usesKeyLength
+)
+
+// algorithm contains the derivation function of a password hash algorithm and the parameters it uses.
+type algorithm struct {
+	// derive derives the password hash. The salt in the parameters is never empty.
+	derive func(password []byte, p *Parameters) (*Result, error)
+
+	// usage specifies which cost parameters are used.
+	usage parameterUsage
+
+	// newSalt generates a salt, if none is specified.
+	newSalt func() ([]byte, error)
+
+	// checkPassword checks the password before it is hashed, but not before it is verified.
+	// It is nil, if every password is accepted.
+	checkPassword func(password []byte) error
+}
+
+// ******** Private constants ********
+
+// defaultSaltSize is the size of a generated salt in bytes.
+const defaultSaltSize = 16
+
+// defaultKeyLength is the default length of a derived key in bytes.
+const defaultKeyLength = 32
+
+// maxMemorySize is the maximum memory size in bytes that a password hash may use.
+const maxMemorySize = 4 << 30
+
+// ******** Private variables ********
+
+// nameToAlgorithm maps the password hash algorithm names to the algorithms.
+var nameToAlgorithm = make(map[string]*algorithm)
+
+// b64 is the base64 encoding of the PHC string format, i.e. the standard encoding without padding.
+var b64 = base64.RawStdEncoding
+
+// ******** Public functions ********
+
+// Hash calculates the password hash of the password with the named algorithm.
+func Hash(name string, password []byte, p *Parameters) (*Result, error) {
+	a, ok := nameToAlgorithm[name]
+	if !ok {
+		return nil, ErrUnknownAlgorithm
+	}
+
+	if a.checkPassword != nil {
+		err := a.checkPassword(password)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return a.hash(name, password, p)
+}
+
+// IsKnown returns true, if the password hash algorithm name is known.
+func IsKnown(name string) bool {
+	_, ok := nameToAlgorithm[name]
+
+	return ok
+}
+
+// KnownNames returns the sorted names of the password hash algorithms.
+func KnownNames() []string {
+	result := make([]string, 0, len(nameToAlgorithm))
+	for name := range nameToAlgorithm {
+		result = append(result, name)
+	}
+
+	slices.Sort(result)
+
+	return result
+}
+
+// ******** Private functions ********
+
+// register registers a password hash algorithm that uses a random salt with the default salt size.
+func register(name string, derive func(password []byte, p *Parameters) (*Result, error), usage parameterUsage) {
+	registerWithSalt(name, derive, usage, randomSalt)
+}
+
+// registerWithSalt registers a password hash algorithm with its own salt generator.
+func registerWithSalt(
+	name string,
+	derive func(password []byte, p *Parameters) (*Result, error),
+	usage parameterUsage,
+	newSalt func() ([]byte, error),
+) {
+	nameToAlgorithm[name] = &algorithm{
+		derive:  derive,
+		usage:   usage,
+		newSalt: newSalt,
+	}
+}
+
+// randomSalt generates a random salt with the default salt size.
+func randomSalt() ([]byte, error) {
+	result := make([]byte, defaultSaltSize)
+	_, err := rand.Read(result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// hash checks the parameters, generates a salt, if none is specified, and derives the password hash.
+func (a *algorithm) hash(name string, password []byte, p *Parameters) (*Result, error) {
+	err := a.checkParameters(name, p)
+	if err != nil {
+		return nil, err
+	}
+
+	// The caller's parameters are not modified.
+	pc := *p
+	if len(pc.Salt) == 0 {
+		pc.Salt, err = a.newSalt()
+		if err != nil {
+			return nil, fmt.Errorf(`could not generate salt: %w`, err)
+		}
+	}
+
+	return a.derive(password, &pc)
+}
+
+// checkParameters checks whether the parameters are valid for the algorithm.
+func (a *algorithm) checkParameters(name string, p *Parameters) error {
+	for _, c := range []struct {
+		value int
+		flag  parameterUsage
+		text  string
+	}{
+		{p.Iterations, usesIterations, `number of iterations`},
+		{p.Memory, usesMemory, `memory size`},
+		{p.Parallelism, usesParallelism, `parallelism`},
+		{p.Cost, usesCost, `cost`},
+		{p.BlockFactor, usesBlockFactor, `block factor`},
+		{p.KeyLength, usesKeyLength, `key length`},
+	} {
+		if c.value < 0 {
+			return fmt.Errorf(`%s must not be negative`, c.text)
+		}
+
+		if c.value != 0 && a.usage&c.flag == 0 {
+			return fmt.Errorf(`a %s is not supported by %s`, c.text, name)
+		}
+	}
+
+	return nil
+}
+
+// valueOrDefault returns the value, if it is not 0, and the default value otherwise.
+func valueOrDefault(value int, defaultValue int) int {
+	if value != 0 {
+		return value
+	}
+
+	return defaultValue
+}
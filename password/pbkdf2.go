@@ -0,0 +1,114 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.1.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//    2026-10-16: V1.1.0: Parse encoded password hashes.
+//
+
+package password
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/sha3"
+	"hash"
+	"strings"
+)
+
+// ******** Private functions ********
+
+// init registers the PBKDF2 functions.
+// The default numbers of iterations are the recommendations of the OWASP password storage cheat sheet.
+// The identifiers are the ones used by other PHC string implementations.
+func init() {
+	registerPBKDF2(`pbkdf2-sha1`, `pbkdf2-sha1`, sha1.New, 1_300_000)
+	registerPBKDF2(`pbkdf2-sha2-224`, `pbkdf2-sha224`, sha256.New224, 600_000)
+	registerPBKDF2(`pbkdf2-sha2-256`, `pbkdf2-sha256`, sha256.New, 600_000)
+	registerPBKDF2(`pbkdf2-sha2-384`, `pbkdf2-sha384`, sha512.New384, 210_000)
+	registerPBKDF2(`pbkdf2-sha2-512`, `pbkdf2-sha512`, sha512.New, 210_000)
+	registerPBKDF2(`pbkdf2-sha3-256`, `pbkdf2-sha3-256`, sha3.New256, 600_000)
+	registerPBKDF2(`pbkdf2-sha3-512`, `pbkdf2-sha3-512`, sha3.New512, 210_000)
+}
+
+// registerPBKDF2 registers a PBKDF2 function with HMAC and the given hash function.
+// The default key length is the size of the hash function.
+func registerPBKDF2(name string, id string, newHash func() hash.Hash, defaultIterations int) {
+	register(
+		name,
+		func(password []byte, p *Parameters) (*Result, error) {
+			iterations := valueOrDefault(p.Iterations, defaultIterations)
+			keyLength := valueOrDefault(p.KeyLength, newHash().Size())
+
+			derivedKey := pbkdf2.Key(password, p.Salt, iterations, keyLength, newHash)
+
+			return &Result{
+				Key:  derivedKey,
+				Salt: p.Salt,
+				Encoded: fmt.Sprintf(`$%s$i=%d$%s$%s`,
+					id,
+					iterations,
+					b64.EncodeToString(p.Salt),
+					b64.EncodeToString(derivedKey),
+				),
+			}, nil
+		},
+		usesIterations|usesKeyLength,
+	)
+
+	registerParser(id, newPBKDF2Parser(name))
+}
+
+// newPBKDF2Parser returns a parser for an encoded PBKDF2 password hash like "$pbkdf2-sha256$i=600000$salt$hash".
+// The format of passlib "$pbkdf2-sha256$600000$salt$hash" with '.' instead of '+' in the base64 encoding is accepted, as well.
+func newPBKDF2Parser(name string) parser {
+	return func(fields []string) (string, *Parameters, []byte, error) {
+		if len(fields) != 4 {
+			return ``, nil, nil, ErrInvalidFormat
+		}
+
+		iterationsText, isPHC := strings.CutPrefix(fields[1], `i=`)
+		iterations, err := parsePositive(iterationsText)
+		if err != nil {
+			return ``, nil, nil, err
+		}
+
+		saltText := fields[2]
+		keyText := fields[3]
+		if !isPHC {
+			saltText = strings.ReplaceAll(saltText, `.`, `+`)
+			keyText = strings.ReplaceAll(keyText, `.`, `+`)
+		}
+
+		salt, key, err := decodeSaltAndKey(saltText, keyText)
+		if err != nil {
+			return ``, nil, nil, err
+		}
+
+		return name, &Parameters{Salt: salt, Iterations: iterations, KeyLength: len(key)}, key, nil
+	}
+}
@@ -0,0 +1,134 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.2.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//    2026-10-16: V1.1.0: Parse encoded password hashes.
+//    2026-10-16: V1.2.0: Limit the memory size.
+//
+
+package password
+
+import (
+	"fmt"
+	"golang.org/x/crypto/scrypt"
+)
+
+// ******** Private constants ********
+
+// These are the default scrypt parameters. They are the recommendation of the OWASP password storage cheat sheet.
+const (
+	scryptDefaultCost        = 17
+	scryptDefaultBlockFactor = 8
+	scryptDefaultParallelism = 1
+)
+
+// scryptBlockSize is the size in bytes of a block of scrypt with a block factor of 1.
+const scryptBlockSize = 128
+
+// scryptMaxCost is the maximum binary logarithm of the CPU/memory cost parameter N.
+// N blocks with the smallest block factor of 1 need the maximum memory size.
+const scryptMaxCost = 25
+
+// ******** Private functions ********
+
+// init registers the scrypt function.
+func init() {
+	register(`scrypt`, deriveScrypt, usesCost|usesBlockFactor|usesParallelism|usesKeyLength)
+	registerParser(`scrypt`, parseScrypt)
+}
+
+// deriveScrypt derives a password hash with scrypt.
+func deriveScrypt(password []byte, p *Parameters) (*Result, error) {
+	cost := valueOrDefault(p.Cost, scryptDefaultCost)
+	blockFactor := valueOrDefault(p.BlockFactor, scryptDefaultBlockFactor)
+	parallelism := valueOrDefault(p.Parallelism, scryptDefaultParallelism)
+	keyLength := valueOrDefault(p.KeyLength, defaultKeyLength)
+
+	err := checkScryptParameters(cost, blockFactor, parallelism)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := scrypt.Key(password, p.Salt, 1<<cost, blockFactor, parallelism, keyLength)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		Key:  derivedKey,
+		Salt: p.Salt,
+		Encoded: fmt.Sprintf(`$scrypt$ln=%d,r=%d,p=%d$%s$%s`,
+			cost,
+			blockFactor,
+			parallelism,
+			b64.EncodeToString(p.Salt),
+			b64.EncodeToString(derivedKey),
+		),
+	}, nil
+}
+
+// checkScryptParameters checks the scrypt parameters.
+func checkScryptParameters(cost int, blockFactor int, parallelism int) error {
+	if cost > scryptMaxCost {
+		return fmt.Errorf(`cost must be between 1 and %d`, scryptMaxCost)
+	}
+
+	// scrypt needs N blocks for its table and one block per lane, each of them with a size of 128*r bytes.
+	// The divisions prevent an overflow.
+	if parallelism > maxMemorySize/scryptBlockSize ||
+		blockFactor > maxMemorySize/(scryptBlockSize*(1<<cost+parallelism)) {
+		return fmt.Errorf(`cost, block factor and parallelism must not need more than %d MiB of memory`, maxMemorySize>>20)
+	}
+
+	return nil
+}
+
+// parseScrypt parses an encoded scrypt password hash like "$scrypt$ln=17,r=8,p=1$salt$hash".
+func parseScrypt(fields []string) (string, *Parameters, []byte, error) {
+	if len(fields) != 4 {
+		return ``, nil, nil, ErrInvalidFormat
+	}
+
+	values, err := parseParameterList(fields[1], `ln`, `r`, `p`)
+	if err != nil {
+		return ``, nil, nil, err
+	}
+
+	salt, key, err := decodeSaltAndKey(fields[2], fields[3])
+	if err != nil {
+		return ``, nil, nil, err
+	}
+
+	return `scrypt`,
+		&Parameters{
+			Salt:        salt,
+			Cost:        values[0],
+			BlockFactor: values[1],
+			Parallelism: values[2],
+			KeyLength:   len(key),
+		},
+		key,
+		nil
+}
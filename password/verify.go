@@ -0,0 +1,151 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.1.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//    2026-10-16: V1.1.0: Reject unsupported parameters and do not check passwords.
+//
+
+package password
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ******** Public variables ********
+
+// ErrInvalidFormat is returned when an encoded password hash has an invalid format.
+var ErrInvalidFormat = errors.New(`invalid password hash format`)
+
+// ErrUnsupportedFormat is returned when the identifier of an encoded password hash is not supported.
+var ErrUnsupportedFormat = errors.New(`unsupported password hash format`)
+
+// ******** Private types ********
+
+// parser parses the fields of an encoded password hash, i.e. the parts between the '$' characters.
+// It returns the name of the algorithm, the parameters including the salt, and the stored key.
+type parser func(fields []string) (string, *Parameters, []byte, error)
+
+// ******** Private variables ********
+
+// idToParser maps the identifiers of the encoded password hashes to their parsers.
+var idToParser = make(map[string]parser)
+
+// ******** Public functions ********
+
+// Verify checks whether the password matches the encoded password hash.
+// The encoded password hash is in the PHC string format or in the modular crypt format.
+// The keys are compared in constant time.
+// Parameters that are out of the supported range, e.g. because they need too much memory,
+// result in an ErrUnsupportedFormat error.
+// Passwords are not checked like they are by [Hash], e.g. bcrypt uses only the first 72 bytes of a password.
+func Verify(encoded string, password []byte) (bool, error) {
+	if !strings.HasPrefix(encoded, `$`) {
+		return false, ErrInvalidFormat
+	}
+
+	fields := strings.Split(encoded[1:], `$`)
+
+	parse, ok := idToParser[fields[0]]
+	if !ok {
+		return false, ErrUnsupportedFormat
+	}
+
+	name, p, storedKey, err := parse(fields)
+	if err != nil {
+		return false, err
+	}
+
+	if len(p.Salt) == 0 || len(storedKey) == 0 {
+		return false, ErrInvalidFormat
+	}
+
+	result, err := nameToAlgorithm[name].hash(name, password, p)
+	if err != nil {
+		return false, fmt.Errorf(`%w: %w`, ErrUnsupportedFormat, err)
+	}
+
+	return subtle.ConstantTimeCompare(result.Key, storedKey) == 1, nil
+}
+
+// ******** Private functions ********
+
+// registerParser registers the parser of an encoded password hash identifier.
+func registerParser(id string, parse parser) {
+	idToParser[id] = parse
+}
+
+// parseParameterList parses a parameter list like "m=65536,t=3,p=4".
+// The parameters must have the given names in the given order and positive values.
+func parseParameterList(text string, names ...string) ([]int, error) {
+	parts := strings.Split(text, `,`)
+	if len(parts) != len(names) {
+		return nil, ErrInvalidFormat
+	}
+
+	result := make([]int, len(names))
+	for i, part := range parts {
+		valueText, ok := strings.CutPrefix(part, names[i]+`=`)
+		if !ok {
+			return nil, ErrInvalidFormat
+		}
+
+		var err error
+		result[i], err = parsePositive(valueText)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// parsePositive parses a positive decimal number.
+func parsePositive(text string) (int, error) {
+	value, err := strconv.Atoi(text)
+	if err != nil || value <= 0 {
+		return 0, fmt.Errorf(`%w: invalid number '%s'`, ErrInvalidFormat, text)
+	}
+
+	return value, nil
+}
+
+// decodeSaltAndKey decodes the base64 encoded salt and key of a PHC string.
+func decodeSaltAndKey(saltText string, keyText string) ([]byte, []byte, error) {
+	salt, err := b64.DecodeString(saltText)
+	if err != nil {
+		return nil, nil, fmt.Errorf(`%w: invalid salt: %w`, ErrInvalidFormat, err)
+	}
+
+	key, err := b64.DecodeString(keyText)
+	if err != nil {
+		return nil, nil, fmt.Errorf(`%w: invalid hash: %w`, ErrInvalidFormat, err)
+	}
+
+	return salt, key, nil
+}
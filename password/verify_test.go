@@ -0,0 +1,122 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package password
+
+import (
+	"errors"
+	"testing"
+)
+
+// ******** Private types ********
+
+// verifyVector is an encoded password hash with the password it has been created from.
+type verifyVector struct {
+	encoded  string
+	password string
+}
+
+// ******** Private variables ********
+
+// cryptVectors contains encoded crypt(3) password hashes.
+// The SHA-crypt hashes are from the specification "Unix crypt using SHA-256 and SHA-512" by Ulrich Drepper.
+// The MD5-crypt and APR1-MD5 hashes have been created with "openssl passwd".
+var cryptVectors = []verifyVector{
+	{encoded: `$5$saltstring$5B8vYYiY.CVt1RlTTf8KbXBH3hsxY/GNooZaBBGWEc5`, password: `Hello world!`},
+	{encoded: `$5$rounds=10000$saltstringsaltst$3xv.VbSHBb41AL9AvLeujZkZRBAwqFMz2.opqey6IcA`, password: `Hello world!`},
+	{encoded: `$5$rounds=1400$anotherlongsalts$Rx.j8H.h8HjEDGomFU8bDkXm3XIUnzyxf12oP84Bnq1`, password: `a very much longer text to encrypt.  This one even stretches over morethan one line.`},
+	{encoded: `$5$rounds=1000$roundstoolow$yfvwcWrQ8l/K0DAWyuPMDNHpIVlTQebY9l/gL972bIC`, password: `the minimum number is still observed`},
+	{encoded: `$6$saltstring$svn8UoSVapNtMuq1ukKS4tPQd8iKwSMHWjl/O817G3uBnIFNjnQJuesI68u4OTLiBFdcbYEdFCoEOfaS35inz1`, password: `Hello world!`},
+	{encoded: `$6$rounds=10000$saltstringsaltst$OW1/O6BYHV6BcXZu8QVeXbDWra3Oeqh0sbHbbMCVNSnCM/UrjmM0Dp8vOuZeHBy/YTBmSK6H9qs/y3RnOaw5v.`, password: `Hello world!`},
+	{encoded: `$6$rounds=77777$short$WuQyW2YR.hBNpjjRhpYD/ifIw05xdfeEyQoMxIXbkvr0gge1a1x3yRULJ5CCaUeOxFmtlcGZelFl5CxtgfiAc0`, password: `we have a short salt string but not a short password`},
+	{encoded: `$6$rounds=1000$roundstoolow$kUMsbe306n21p9R.FRkW3IGn.S9NPN0x50YhH1xhLsPuWGsUSklZt58jaTfF4ZEQpyUNGc0dqbpBYYBaHHrsX.`, password: `the minimum number is still observed`},
+	{encoded: `$1$saltstri$YMyguxXMBpd2TEZ.vS/3q1`, password: `Hello world!`},
+	{encoded: `$1$saltstri$qQY4WxjABChYG1ccLpfkz/`, password: `password`},
+	{encoded: `$apr1$saltstri$aGfuB7Lcvs2TUeFTqUVfN0`, password: `Hello world!`},
+	{encoded: `$apr1$ab/.XY$BZ0LRZppoQ8bdYuOJ4mOc.`, password: ``},
+}
+
+// ******** Test functions ********
+
+// TestVerifyCrypt tests the verification of crypt(3) password hashes with the right and a wrong password.
+func TestVerifyCrypt(t *testing.T) {
+	for _, v := range cryptVectors {
+		checkVerify(t, v.encoded, v.password, true)
+		checkVerify(t, v.encoded, v.password+`x`, false)
+	}
+}
+
+// TestHashAndVerify tests that the crypt(3) password hashes created by Hash are verified by Verify.
+func TestHashAndVerify(t *testing.T) {
+	const password = `correct horse battery staple`
+
+	for _, name := range []string{`sha256-crypt`, `sha512-crypt`, `md5-crypt`, `apr1`} {
+		r, err := Hash(name, []byte(password), &Parameters{})
+		if err != nil {
+			t.Fatalf(`%s: unexpected error: %v`, name, err)
+		}
+
+		checkVerify(t, r.Encoded, password, true)
+		checkVerify(t, r.Encoded, `wrong password`, false)
+	}
+}
+
+// TestVerifyErrors tests that malformed and unsupported encoded password hashes are rejected.
+func TestVerifyErrors(t *testing.T) {
+	for _, c := range []struct {
+		encoded  string
+		expected error
+	}{
+		{encoded: ``, expected: ErrInvalidFormat},
+		{encoded: `saltstri$YMyguxXMBpd2TEZ.vS/3q1`, expected: ErrInvalidFormat},
+		{encoded: `$1$$YMyguxXMBpd2TEZ.vS/3q1`, expected: ErrInvalidFormat},
+		{encoded: `$1$saltstri$YMyguxXMBpd2TEZ.vS/3q`, expected: ErrInvalidFormat},
+		{encoded: `$9$saltstri$YMyguxXMBpd2TEZ.vS/3q1`, expected: ErrUnsupportedFormat},
+	} {
+		_, err := Verify(c.encoded, []byte(`Hello world!`))
+		if !errors.Is(err, c.expected) {
+			t.Errorf(`"%s": got error %v, expected %v`, c.encoded, err, c.expected)
+		}
+	}
+}
+
+// ******** Private functions ********
+
+// checkVerify checks that Verify returns the expected result for the password.
+func checkVerify(t *testing.T, encoded string, password string, expected bool) {
+	t.Helper()
+
+	ok, err := Verify(encoded, []byte(password))
+	if err != nil {
+		t.Fatalf(`%s: unexpected error: %v`, encoded, err)
+	}
+
+	if ok != expected {
+		t.Errorf(`%s: password "%s" got %t, expected %t`, encoded, password, ok, expected)
+	}
+}
@@ -0,0 +1,108 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.1.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//    2026-10-16: V1.1.0: Add password verification.
+//
+
+package main
+
+import (
+	"encoding/hex"
+	"hashvalue/encodedprinting"
+	"hashvalue/password"
+	"os"
+)
+
+// ******** Private functions ********
+
+// hashPassword calculates the password hash of the source and prints it.
+func hashPassword(encodedPrinter encodedprinting.EncodedPrinter) int {
+	pw, err := passwordBytes()
+	if err != nil {
+		return printErrorf(`Error reading password: %v`, err)
+	}
+
+	parameters := &password.Parameters{
+		Salt:        saltBytes,
+		Iterations:  iterations,
+		Memory:      memory,
+		Parallelism: parallelism,
+		Cost:        cost,
+		BlockFactor: blockFactor,
+		KeyLength:   outputLength,
+	}
+
+	result, err := password.Hash(passwordHashAlgorithm, pw, parameters)
+	if err != nil {
+		return printUsageErrorf(`Invalid parameters for password hash algorithm '%s': %v`, passwordHashAlgorithm, err)
+	}
+
+	if usePHC {
+		_, _ = os.Stdout.WriteString(result.Encoded + "\n")
+		return rcOK
+	}
+
+	// The raw key is useless without the salt, so a generated salt is shown.
+	if len(saltBytes) == 0 {
+		printWarningf(`Generated salt: %s`, hex.EncodeToString(result.Salt))
+	}
+
+	encodedPrinter.PrintEncoded(result.Key)
+
+	return rcOK
+}
+
+// verifyPasswordHash verifies the source against the encoded password hash and prints the result.
+func verifyPasswordHash() int {
+	pw, err := passwordBytes()
+	if err != nil {
+		return printErrorf(`Error reading password: %v`, err)
+	}
+
+	matches, err := password.Verify(verifyPassword, pw)
+	if err != nil {
+		return printErrorf(`Error verifying password: %v`, err)
+	}
+
+	if !matches {
+		_, _ = os.Stdout.WriteString("Password does not match\n")
+		return rcMismatch
+	}
+
+	_, _ = os.Stdout.WriteString("Password matches\n")
+
+	return rcOK
+}
+
+// passwordBytes returns the password from the single source part.
+func passwordBytes() ([]byte, error) {
+	part := sourceParts[0]
+	if part.sourceType == sourceTypeFile {
+		return os.ReadFile(part.text)
+	}
+
+	return part.data, nil
+}
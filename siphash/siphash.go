@@ -0,0 +1,218 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+// Package siphash implements the SipHash pseudorandom functions SipHash-2-4 and SipHash-1-3
+// by Jean-Philippe Aumasson and Daniel J. Bernstein (https://www.aumasson.jp/siphash/siphash.pdf).
+//
+// The hash value is returned as a 64 bit value in big-endian byte order.
+// Note that the test vectors of the reference implementation list the bytes in little-endian byte order.
+package siphash
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+)
+
+// ******** Public constants ********
+
+// Size is the size of a SipHash value in bytes.
+const Size = 8
+
+// KeySize is the size of a SipHash key in bytes.
+const KeySize = 16
+
+// BlockSize is the block size of SipHash in bytes.
+const BlockSize = 8
+
+// ******** Public variables ********
+
+// ErrKeySize is returned when a key does not have the size KeySize.
+var ErrKeySize = errors.New(`siphash: key must be 16 bytes long`)
+
+// ******** Public types ********
+
+// Digest implements a SipHash function.
+type Digest struct {
+	k0             uint64
+	k1             uint64
+	v0, v1, v2, v3 uint64
+	compression    int
+	finalization   int
+	buffer         [BlockSize]byte
+	bufferedSize   int
+	totalLength    uint64
+}
+
+// ******** Public functions ********
+
+// New24 creates a new SipHash-2-4 function with the given key.
+// The key must be KeySize bytes long.
+func New24(key []byte) (*Digest, error) {
+	return newDigest(key, 2, 4)
+}
+
+// New13 creates a new SipHash-1-3 function with the given key.
+// The key must be KeySize bytes long.
+func New13(key []byte) (*Digest, error) {
+	return newDigest(key, 1, 3)
+}
+
+// -------- Digest methods --------
+
+// Write adds more data to the running hash.
+func (d *Digest) Write(p []byte) (int, error) {
+	n := len(p)
+	d.totalLength += uint64(n)
+
+	if d.bufferedSize != 0 {
+		copied := copy(d.buffer[d.bufferedSize:], p)
+		d.bufferedSize += copied
+		p = p[copied:]
+
+		if d.bufferedSize < BlockSize {
+			return n, nil
+		}
+
+		d.block(binary.LittleEndian.Uint64(d.buffer[:]))
+		d.bufferedSize = 0
+	}
+
+	for len(p) >= BlockSize {
+		d.block(binary.LittleEndian.Uint64(p))
+		p = p[BlockSize:]
+	}
+
+	d.bufferedSize = copy(d.buffer[:], p)
+
+	return n, nil
+}
+
+// Sum64 returns the current hash value.
+func (d *Digest) Sum64() uint64 {
+	last := d.totalLength << 56
+	for i := d.bufferedSize - 1; i >= 0; i-- {
+		last |= uint64(d.buffer[i]) << (8 * i)
+	}
+
+	v0, v1, v2, v3 := d.v0, d.v1, d.v2, d.v3
+
+	v3 ^= last
+	for range d.compression {
+		v0, v1, v2, v3 = sipRound(v0, v1, v2, v3)
+	}
+	v0 ^= last
+
+	v2 ^= 0xff
+	for range d.finalization {
+		v0, v1, v2, v3 = sipRound(v0, v1, v2, v3)
+	}
+
+	return v0 ^ v1 ^ v2 ^ v3
+}
+
+// Sum appends the current hash value in big-endian byte order to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (d *Digest) Sum(b []byte) []byte {
+	return binary.BigEndian.AppendUint64(b, d.Sum64())
+}
+
+// Reset resets the hash to its initial state.
+func (d *Digest) Reset() {
+	d.v0 = d.k0 ^ 0x736f6d6570736575
+	d.v1 = d.k1 ^ 0x646f72616e646f6d
+	d.v2 = d.k0 ^ 0x6c7967656e657261
+	d.v3 = d.k1 ^ 0x7465646279746573
+	d.bufferedSize = 0
+	d.totalLength = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (d *Digest) Size() int {
+	return Size
+}
+
+// BlockSize returns the hash's underlying block size.
+func (d *Digest) BlockSize() int {
+	return BlockSize
+}
+
+// block processes one message word.
+func (d *Digest) block(m uint64) {
+	v0, v1, v2, v3 := d.v0, d.v1, d.v2, d.v3
+
+	v3 ^= m
+	for range d.compression {
+		v0, v1, v2, v3 = sipRound(v0, v1, v2, v3)
+	}
+	v0 ^= m
+
+	d.v0, d.v1, d.v2, d.v3 = v0, v1, v2, v3
+}
+
+// ******** Private functions ********
+
+// newDigest creates a SipHash function with the given number of compression and finalization rounds.
+func newDigest(key []byte, compression int, finalization int) (*Digest, error) {
+	if len(key) != KeySize {
+		return nil, ErrKeySize
+	}
+
+	d := &Digest{
+		k0:           binary.LittleEndian.Uint64(key),
+		k1:           binary.LittleEndian.Uint64(key[8:]),
+		compression:  compression,
+		finalization: finalization,
+	}
+	d.Reset()
+
+	return d, nil
+}
+
+// sipRound is the round function of SipHash.
+func sipRound(v0, v1, v2, v3 uint64) (uint64, uint64, uint64, uint64) {
+	v0 += v1
+	v1 = bits.RotateLeft64(v1, 13)
+	v1 ^= v0
+	v0 = bits.RotateLeft64(v0, 32)
+
+	v2 += v3
+	v3 = bits.RotateLeft64(v3, 16)
+	v3 ^= v2
+
+	v0 += v3
+	v3 = bits.RotateLeft64(v3, 21)
+	v3 ^= v0
+
+	v2 += v1
+	v1 = bits.RotateLeft64(v1, 17)
+	v1 ^= v2
+	v2 = bits.RotateLeft64(v2, 32)
+
+	return v0, v1, v2, v3
+}
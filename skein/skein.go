@@ -0,0 +1,260 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+// Package skein implements the Skein hash functions version 1.3 by Niels Ferguson, Stefan Lucks,
+// Bruce Schneier, Doug Whiting, Mihir Bellare, Tadayoshi Kohno, Jon Callas and Jesse Walker,
+// as submitted to the final round of the SHA-3 competition.
+//
+// Skein-256, Skein-512 and Skein-1024 are supported with arbitrary output sizes and the MAC mode.
+package skein
+
+import (
+	"encoding/binary"
+	"slices"
+)
+
+// ******** Public constants ********
+
+// These are the state sizes of the Skein functions in bytes. They are also the default output sizes.
+const (
+	Size256  = 32
+	Size512  = 64
+	Size1024 = 128
+)
+
+// ******** Private constants ********
+
+// These are the block types of the Unique Block Iteration (UBI).
+const (
+	typeKey    uint64 = 0
+	typeConfig uint64 = 4
+	typeMsg    uint64 = 48
+	typeOut    uint64 = 63
+)
+
+// These are the flags in the second word of the tweak.
+const (
+	flagFirst uint64 = 1 << 62
+	flagFinal uint64 = 1 << 63
+)
+
+// typeShift is the position of the block type in the second word of the tweak.
+const typeShift = 56
+
+// schemaIdentifier is the schema identifier "SHA3" and the version 1 of the configuration block.
+const schemaIdentifier uint64 = 0x0000_0001_3341_4853
+
+// ******** Public types ********
+
+// Digest implements the Skein hash functions.
+type Digest struct {
+	cipher       *threefish
+	initialChain [16]uint64
+	chain        [16]uint64
+	buffer       [Size1024]byte
+	bufferedSize int
+	position     uint64
+	outputLength int
+}
+
+// ******** Public functions ********
+
+// New256 creates a new Skein-256 function with the given key and output length in bytes.
+// If the key is not empty, the MAC mode is used. If the output length is 0, the state size is used.
+func New256(key []byte, outputLength int) *Digest {
+	return newDigest(threefish256, key, outputLength)
+}
+
+// New512 creates a new Skein-512 function with the given key and output length in bytes.
+// If the key is not empty, the MAC mode is used. If the output length is 0, the state size is used.
+func New512(key []byte, outputLength int) *Digest {
+	return newDigest(threefish512, key, outputLength)
+}
+
+// New1024 creates a new Skein-1024 function with the given key and output length in bytes.
+// If the key is not empty, the MAC mode is used. If the output length is 0, the state size is used.
+func New1024(key []byte, outputLength int) *Digest {
+	return newDigest(threefish1024, key, outputLength)
+}
+
+// -------- Digest methods --------
+
+// Write adds more data to the running hash.
+func (d *Digest) Write(p []byte) (int, error) {
+	n := len(p)
+	blockSize := d.BlockSize()
+
+	// The last block is kept in the buffer, as it has to be processed with the final flag.
+	for len(p) > 0 {
+		if d.bufferedSize == blockSize {
+			d.block(d.buffer[:blockSize], blockSize, typeMsg, 0)
+			d.bufferedSize = 0
+		}
+
+		copied := copy(d.buffer[d.bufferedSize:blockSize], p)
+		d.bufferedSize += copied
+		p = p[copied:]
+	}
+
+	return n, nil
+}
+
+// Sum appends the current hash value to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (d *Digest) Sum(b []byte) []byte {
+	// Work on a copy, so that the caller can keep writing.
+	c := *d
+	blockSize := c.BlockSize()
+
+	clear(c.buffer[c.bufferedSize:blockSize])
+	c.block(c.buffer[:blockSize], c.bufferedSize, typeMsg, flagFinal)
+
+	// The output is generated by UBI with a counter in output mode.
+	result, out := sliceForAppend(b, c.outputLength)
+
+	var counter [Size1024]byte
+	var outBlock [Size1024]byte
+	for i := uint64(0); len(out) > 0; i++ {
+		o := c
+		o.position = 0
+		binary.LittleEndian.PutUint64(counter[:], i)
+		o.block(counter[:blockSize], 8, typeOut, flagFinal)
+		putWords(outBlock[:blockSize], o.chain[:c.cipher.words])
+
+		copied := copy(out, outBlock[:blockSize])
+		out = out[copied:]
+	}
+
+	return result
+}
+
+// Reset resets the hash to its initial state.
+func (d *Digest) Reset() {
+	d.chain = d.initialChain
+	d.bufferedSize = 0
+	d.position = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (d *Digest) Size() int {
+	return d.outputLength
+}
+
+// BlockSize returns the hash's underlying block size.
+func (d *Digest) BlockSize() int {
+	return d.cipher.words << 3
+}
+
+// block processes one block that contains length data bytes with UBI.
+// The first flag is set, when this is the first block of the UBI.
+func (d *Digest) block(p []byte, length int, blockType uint64, flags uint64) {
+	if d.position == 0 {
+		flags |= flagFirst
+	}
+
+	d.position += uint64(length)
+	tweak := [2]uint64{d.position, blockType<<typeShift | flags}
+
+	var message, result [16]uint64
+	n := d.cipher.words
+	for i := 0; i < n; i++ {
+		message[i] = binary.LittleEndian.Uint64(p[8*i:])
+	}
+
+	d.cipher.encrypt(result[:n], d.chain[:n], &tweak, message[:n])
+
+	for i := 0; i < n; i++ {
+		d.chain[i] = result[i] ^ message[i]
+	}
+}
+
+// ubi processes a complete input of the given type with UBI.
+func (d *Digest) ubi(p []byte, blockType uint64) {
+	blockSize := d.BlockSize()
+	d.position = 0
+
+	for {
+		var block [Size1024]byte
+		length := copy(block[:blockSize], p)
+		p = p[length:]
+
+		if len(p) == 0 {
+			d.block(block[:blockSize], length, blockType, flagFinal)
+			return
+		}
+
+		d.block(block[:blockSize], length, blockType, 0)
+	}
+}
+
+// ******** Private functions ********
+
+// newDigest creates a new Skein function with the given Threefish cipher.
+// The key and the configuration are processed and build the initial chaining value.
+func newDigest(cipher *threefish, key []byte, outputLength int) *Digest {
+	if outputLength == 0 {
+		outputLength = cipher.words << 3
+	}
+
+	d := &Digest{
+		cipher:       cipher,
+		outputLength: outputLength,
+	}
+
+	if len(key) != 0 {
+		d.ubi(key, typeKey)
+	}
+
+	// The configuration block contains the schema identifier, the version and the output length in bits.
+	// The tree parameters are 0, as only sequential hashing is supported.
+	var config [32]byte
+	binary.LittleEndian.PutUint64(config[0:], schemaIdentifier)
+	binary.LittleEndian.PutUint64(config[8:], uint64(outputLength)<<3)
+	d.ubi(config[:], typeConfig)
+
+	d.initialChain = d.chain
+	d.Reset()
+
+	return d
+}
+
+// putWords stores the words in little-endian byte order in p.
+func putWords(p []byte, words []uint64) {
+	for i, w := range words {
+		binary.LittleEndian.PutUint64(p[8*i:], w)
+	}
+}
+
+// sliceForAppend extends b by n bytes. It returns the extended slice
+// and the slice that contains the n appended bytes.
+func sliceForAppend(b []byte, n int) (result []byte, appended []byte) {
+	result = slices.Grow(b, n)[:len(b)+n]
+	appended = result[len(b):]
+
+	return
+}
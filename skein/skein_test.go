@@ -0,0 +1,120 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package skein
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// ******** Private types ********
+
+// testVector is a Skein test vector.
+// The message is the sequence ff fe fd ... of the given length.
+// The key is the pattern 00 01 02 ... f9 fa 00 01 ... of the given length.
+type testVector struct {
+	newDigest     func([]byte, int) *Digest
+	name          string
+	keyLength     int
+	messageLength int
+	outputLength  int
+	expected      string
+}
+
+// ******** Private variables ********
+
+// The vectors without a key and with the default output length are from appendix C
+// of "The Skein Hash Function Family", version 1.3.
+// The other vectors have been calculated with an independent implementation of the specification.
+
+// testVectors contains the test vectors.
+var testVectors = []testVector{
+	{newDigest: New256, name: `Skein-256`, messageLength: 1, expected: `0b98dcd198ea0e50a7a244c444e25c23da30c10fc9a1f270a6637f1f34e67ed2`},
+	{newDigest: New256, name: `Skein-256`, messageLength: 32, expected: `8d0fa4ef777fd759dfd4044e6f6a5ac3c774aec943dcfc07927b723b5dbf408b`},
+	{newDigest: New256, name: `Skein-256`, messageLength: 64, expected: `df28e916630d0b44c4a849dc9a02f07a07cb30f732318256b15d865ac4ae162f`},
+	{newDigest: New512, name: `Skein-512`, messageLength: 1, expected: `71b7bce6fe6452227b9ced6014249e5bf9a9754c3ad618ccc4e0aae16b316cc8ca698d864307ed3e80b6ef1570812ac5272dc409b5a012df2a579102f340617a`},
+	{newDigest: New512, name: `Skein-512`, messageLength: 64, expected: `45863ba3be0c4dfc27e75d358496f4ac9a736a505d9313b42b2f5eada79fc17f63861e947afb1d056aa199575ad3f8c9a3cc1780b5e5fa4cae050e989876625b`},
+	{newDigest: New512, name: `Skein-512`, messageLength: 128, expected: `91cca510c263c4ddd010530a33073309628631f308747e1bcbaa90e451cab92e5188087af4188773a332303e6667a7a210856f742139000071f48e8ba2a5adb7`},
+	{newDigest: New1024, name: `Skein-1024`, messageLength: 1, expected: `e62c05802ea0152407cdd8787fda9e35703de862a4fbc119cff8590afe79250bccc8b3faf1bd2422ab5c0d263fb2f8afb3f796f048000381531b6f00d85161bc0fff4bef2486b1ebcd3773fabf50ad4ad5639af9040e3f29c6c931301bf79832e9da09857e831e82ef8b4691c235656515d437d2bda33bcec001c67ffde15ba8`},
+	{newDigest: New1024, name: `Skein-1024`, messageLength: 128, expected: `1f3e02c46fb80a3fcd2dfbbc7c173800b40c60c2354af551189ebf433c3d85f9ff1803e6d920493179ed7ae7fce69c3581a5a2f82d3e0c7a295574d0cd7d217c484d2f6313d59a7718ead07d0729c24851d7e7d2491b902d489194e6b7d369db0ab7aa106f0ee0a39a42efc54f18d93776080985f907574f995ec6a37153a578`},
+	{newDigest: New256, name: `Skein-256`, keyLength: 32, messageLength: 32, outputLength: 32, expected: `e744dd11c77f4aff33a5c9fd709737af141fb11ddc691216e6424533b7cce150`},
+	{newDigest: New256, name: `Skein-256`, keyLength: 16, messageLength: 0, outputLength: 20, expected: `f4520754f21b66f3aca2bcbfb3699428786e5c9d`},
+	{newDigest: New256, name: `Skein-256`, keyLength: 0, messageLength: 64, outputLength: 65, expected: `f03dd96e793ccfa849fd3b85155b5265e5f6d3532797b95281704b090baa4b1703aead3bc00bf3f4ec9692faf85d44f48cc25ca617fe471662d7b5f2dbb2ee34e8`},
+	{newDigest: New512, name: `Skein-512`, keyLength: 64, messageLength: 200, outputLength: 64, expected: `0dc4c8fdfae0dfce934fd4c5cdfbed6a5e5edcf36c4e257e6d24d222d0791148d51687d98c922dbcbf752d41bb772e0d1cab36f05f8568da56465bcca233a5d8`},
+	{newDigest: New512, name: `Skein-512`, keyLength: 3, messageLength: 1, outputLength: 28, expected: `4f900dd08042cff5b3fd4b3d474e95afbb350a9bb63a4b652606f292`},
+	{newDigest: New512, name: `Skein-512`, keyLength: 0, messageLength: 0, outputLength: 100, expected: `40792205f2e5e8d802b3128fb017cbf1f22598c6ec2da1b2f65602e287056c68f92b327603f3aee12514cdb0bcd32ad0fd0d2e320cd71dda0b3415b9c832bf30f7eed560c2d95a8f651eaa5ef9747225c96f11adebcd374986070fb3d6a60317ff1fee86`},
+	{newDigest: New1024, name: `Skein-1024`, keyLength: 128, messageLength: 255, outputLength: 128, expected: `4fdb4b37f9e4f067206576fca31b63098163a5ec1435117624053da82fca174d36bbef54a214c22c496d71448b1d18f5d991bbe67ca892fb2f928e096fc22e0923dbd08ce1b521d5b76ac4b153f5b1a16e506aa3b8f053691e759c2a3d0a50304457151a9c46916ade7e6e4b5039928e23db5e61891ab2a3423708384786d723`},
+	{newDigest: New1024, name: `Skein-1024`, keyLength: 0, messageLength: 300, outputLength: 48, expected: `d153f75f561db07044c1c1014672f04dff6d2b1900a5c7199dead49e80aa0b975f1b2a912d05f424710896ff6a0dfa70`},
+	{newDigest: New1024, name: `Skein-1024`, keyLength: 200, messageLength: 1000, outputLength: 129, expected: `3ea572cc297dc2969c51d4ac8463e3e250ffb4b89995b230dd7491b2dc70e633055463fde9d840dbaa3bb38421b8a10de5dae7116a651369ead15f660d313d12efc719fc4831bb2840dba7c7f1a83d00ea223f97861d01980b0463ac0bfd1c77499ccccd600af45bb39a00593b27343face0d9898579521cad1950ee516e1f5cd7`},
+}
+
+// ******** Test functions ********
+
+// TestSkein tests the Skein hash functions.
+func TestSkein(t *testing.T) {
+	for _, v := range testVectors {
+		key := make([]byte, v.keyLength)
+		for i := range key {
+			key[i] = byte(i % 251)
+		}
+
+		message := make([]byte, v.messageLength)
+		for i := range message {
+			message[i] = byte(0xff - i)
+		}
+
+		d := v.newDigest(key, v.outputLength)
+
+		_, _ = d.Write(message)
+		checkResult(t, v, `at once`, d.Sum(nil))
+
+		d.Reset()
+		for i := range message {
+			_, _ = d.Write(message[i : i+1])
+		}
+
+		checkResult(t, v, `byte by byte`, d.Sum(nil))
+
+		// Sum must not change the state.
+		checkResult(t, v, `second sum`, d.Sum(nil))
+	}
+}
+
+// ******** Private functions ********
+
+// checkResult compares a hash value with the expected value.
+func checkResult(t *testing.T, v testVector, mode string, result []byte) {
+	t.Helper()
+
+	resultHex := hex.EncodeToString(result)
+	if resultHex != v.expected {
+		t.Errorf(`%s with key length %d, message length %d and output length %d %s: got %s, expected %s`,
+			v.name, v.keyLength, v.messageLength, v.outputLength, mode, resultHex, v.expected)
+	}
+}
@@ -0,0 +1,144 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package skein
+
+import (
+	"math/bits"
+)
+
+// ******** Private constants ********
+
+// keyScheduleParity is the constant of the key schedule of Threefish.
+const keyScheduleParity uint64 = 0x1bd11bdaa9fc1a22
+
+// ******** Private variables ********
+
+// These are the rotation constants of Threefish for the state sizes of 4, 8 and 16 words.
+var (
+	rotations256 = [8][]int{
+		{14, 16}, {52, 57}, {23, 40}, {5, 37},
+		{25, 33}, {46, 12}, {58, 22}, {32, 32},
+	}
+
+	rotations512 = [8][]int{
+		{46, 36, 19, 37}, {33, 27, 14, 42}, {17, 49, 36, 39}, {44, 9, 54, 56},
+		{39, 30, 34, 24}, {13, 50, 10, 17}, {25, 29, 39, 43}, {8, 35, 56, 22},
+	}
+
+	rotations1024 = [8][]int{
+		{24, 13, 8, 47, 8, 17, 22, 37}, {38, 19, 10, 55, 49, 18, 23, 52},
+		{33, 4, 51, 13, 34, 41, 59, 17}, {5, 20, 48, 41, 47, 28, 16, 25},
+		{41, 9, 37, 31, 12, 47, 44, 30}, {16, 34, 56, 51, 4, 53, 42, 41},
+		{31, 44, 47, 46, 19, 42, 44, 25}, {9, 48, 35, 52, 23, 31, 37, 20},
+	}
+)
+
+// These are the word permutations of Threefish for the state sizes of 4, 8 and 16 words.
+var (
+	permutation256  = []int{0, 3, 2, 1}
+	permutation512  = []int{2, 1, 4, 7, 6, 5, 0, 3}
+	permutation1024 = []int{0, 9, 2, 13, 6, 11, 4, 15, 10, 7, 12, 3, 14, 5, 8, 1}
+)
+
+// ******** Private types ********
+
+// threefish contains the parameters of a Threefish block cipher.
+type threefish struct {
+	words       int
+	rounds      int
+	rotations   *[8][]int
+	permutation []int
+}
+
+// ******** Private variables ********
+
+// These are the Threefish block ciphers for the state sizes of 256, 512 and 1024 bits.
+var (
+	threefish256  = &threefish{words: 4, rounds: 72, rotations: &rotations256, permutation: permutation256}
+	threefish512  = &threefish{words: 8, rounds: 72, rotations: &rotations512, permutation: permutation512}
+	threefish1024 = &threefish{words: 16, rounds: 80, rotations: &rotations1024, permutation: permutation1024}
+)
+
+// ******** Private functions ********
+
+// -------- threefish methods --------
+
+// encrypt encrypts the block with the key and the tweak. The result is written to out.
+// All slices must have the length of the state size in words.
+func (t *threefish) encrypt(out []uint64, key []uint64, tweak *[2]uint64, block []uint64) {
+	n := t.words
+
+	var k [17]uint64
+	copy(k[:], key)
+
+	k[n] = keyScheduleParity
+	for i := 0; i < n; i++ {
+		k[n] ^= k[i]
+	}
+
+	tw := [3]uint64{tweak[0], tweak[1], tweak[0] ^ tweak[1]}
+
+	var v, f [16]uint64
+	copy(v[:], block)
+
+	for round := 0; round < t.rounds; round++ {
+		if round&3 == 0 {
+			t.addSubkey(v[:n], &k, &tw, round>>2)
+		}
+
+		rotation := t.rotations[round&7]
+		for j := 0; j < n; j += 2 {
+			v[j] += v[j+1]
+			v[j+1] = bits.RotateLeft64(v[j+1], rotation[j>>1]) ^ v[j]
+		}
+
+		for i, p := range t.permutation {
+			f[i] = v[p]
+		}
+
+		v = f
+	}
+
+	t.addSubkey(v[:n], &k, &tw, t.rounds>>2)
+
+	copy(out, v[:n])
+}
+
+// addSubkey adds the subkey with the number s to the state.
+func (t *threefish) addSubkey(v []uint64, k *[17]uint64, tw *[3]uint64, s int) {
+	n := t.words
+
+	for i := range v {
+		v[i] += k[(s+i)%(n+1)]
+	}
+
+	v[n-3] += tw[s%3]
+	v[n-2] += tw[(s+1)%3]
+	v[n-1] += uint64(s)
+}
@@ -0,0 +1,220 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+// Package sm3 implements the SM3 hash function as specified in GB/T 32905-2016
+// and in the IETF draft https://datatracker.ietf.org/doc/html/draft-sca-cfrg-sm3.
+package sm3
+
+import (
+	"encoding/binary"
+	"hash"
+	"math/bits"
+)
+
+// ******** Public constants ********
+
+// Size is the size of an SM3 hash value in bytes.
+const Size = 32
+
+// BlockSize is the block size of SM3 in bytes.
+const BlockSize = 64
+
+// ******** Private constants ********
+
+// lengthSize is the size of the length field in the padding in bytes.
+const lengthSize = 8
+
+// Constants of the compression function.
+const (
+	t0 uint32 = 0x79cc4519
+	t1 uint32 = 0x7a879d8a
+)
+
+// ******** Private variables ********
+
+// iv is the initial value of the state.
+var iv = [8]uint32{
+	0x7380166f, 0x4914b2b9, 0x172442d7, 0xda8a0600,
+	0xa96f30bc, 0x163138aa, 0xe38dee4d, 0xb0fb0e4e,
+}
+
+// ******** Public types ********
+
+// Digest implements the SM3 hash function.
+type Digest struct {
+	state        [8]uint32
+	buffer       [BlockSize]byte
+	bufferedSize int
+	totalLength  uint64
+}
+
+// ******** Public functions ********
+
+// New creates a new SM3 hash function.
+func New() hash.Hash {
+	d := &Digest{}
+	d.Reset()
+
+	return d
+}
+
+// -------- Digest methods --------
+
+// Write adds more data to the running hash.
+func (d *Digest) Write(p []byte) (int, error) {
+	n := len(p)
+	d.totalLength += uint64(n)
+
+	if d.bufferedSize != 0 {
+		copied := copy(d.buffer[d.bufferedSize:], p)
+		d.bufferedSize += copied
+		p = p[copied:]
+
+		if d.bufferedSize < BlockSize {
+			return n, nil
+		}
+
+		d.block(d.buffer[:])
+		d.bufferedSize = 0
+	}
+
+	for len(p) >= BlockSize {
+		d.block(p)
+		p = p[BlockSize:]
+	}
+
+	d.bufferedSize = copy(d.buffer[:], p)
+
+	return n, nil
+}
+
+// Sum appends the current hash value to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (d *Digest) Sum(b []byte) []byte {
+	// Work on a copy, so that the caller can keep writing.
+	c := *d
+
+	// Padding: A 1 bit, zero bits and the length in bits as a big-endian 64 bit value.
+	var padding [2 * BlockSize]byte
+	padding[0] = 0x80
+
+	padLen := BlockSize - lengthSize - c.bufferedSize
+	if padLen <= 0 {
+		padLen += BlockSize
+	}
+
+	binary.BigEndian.PutUint64(padding[padLen:], c.totalLength<<3)
+	_, _ = c.Write(padding[:padLen+lengthSize])
+
+	for _, v := range c.state {
+		b = binary.BigEndian.AppendUint32(b, v)
+	}
+
+	return b
+}
+
+// Reset resets the hash to its initial state.
+func (d *Digest) Reset() {
+	d.state = iv
+	d.bufferedSize = 0
+	d.totalLength = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (d *Digest) Size() int {
+	return Size
+}
+
+// BlockSize returns the hash's underlying block size.
+func (d *Digest) BlockSize() int {
+	return BlockSize
+}
+
+// block processes one block with the compression function.
+func (d *Digest) block(p []byte) {
+	// Message expansion.
+	var w [68]uint32
+	for j := 0; j < 16; j++ {
+		w[j] = binary.BigEndian.Uint32(p[4*j:])
+	}
+
+	for j := 16; j < 68; j++ {
+		w[j] = p1(w[j-16]^w[j-9]^bits.RotateLeft32(w[j-3], 15)) ^ bits.RotateLeft32(w[j-13], 7) ^ w[j-6]
+	}
+
+	a, b, c, dd, e, f, g, h := d.state[0], d.state[1], d.state[2], d.state[3], d.state[4], d.state[5], d.state[6], d.state[7]
+
+	for j := 0; j < 64; j++ {
+		var ff, gg, t uint32
+		if j < 16 {
+			ff = a ^ b ^ c
+			gg = e ^ f ^ g
+			t = t0
+		} else {
+			ff = (a & b) | (a & c) | (b & c)
+			gg = (e & f) | (^e & g)
+			t = t1
+		}
+
+		a12 := bits.RotateLeft32(a, 12)
+		ss1 := bits.RotateLeft32(a12+e+bits.RotateLeft32(t, j), 7)
+		ss2 := ss1 ^ a12
+		tt1 := ff + dd + ss2 + (w[j] ^ w[j+4])
+		tt2 := gg + h + ss1 + w[j]
+
+		dd = c
+		c = bits.RotateLeft32(b, 9)
+		b = a
+		a = tt1
+		h = g
+		g = bits.RotateLeft32(f, 19)
+		f = e
+		e = p0(tt2)
+	}
+
+	d.state[0] ^= a
+	d.state[1] ^= b
+	d.state[2] ^= c
+	d.state[3] ^= dd
+	d.state[4] ^= e
+	d.state[5] ^= f
+	d.state[6] ^= g
+	d.state[7] ^= h
+}
+
+// ******** Private functions ********
+
+// p0 is the permutation function of the compression function.
+func p0(x uint32) uint32 {
+	return x ^ bits.RotateLeft32(x, 9) ^ bits.RotateLeft32(x, 17)
+}
+
+// p1 is the permutation function of the message expansion.
+func p1(x uint32) uint32 {
+	return x ^ bits.RotateLeft32(x, 15) ^ bits.RotateLeft32(x, 23)
+}
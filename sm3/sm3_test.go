@@ -0,0 +1,77 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package sm3
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// ******** Private types ********
+
+// testVector is a test vector with a message and its expected hash value.
+type testVector struct {
+	message  string
+	expected string
+}
+
+// ******** Private variables ********
+
+// testVectors contains the examples of GB/T 32905-2016, appendix A.
+var testVectors = []testVector{
+	{`abc`, `66c7f0f462eeedd9d1f2d46bdc10e4e24167c4875cf2f7a2297da02b8f4ba8e0`},
+	{strings.Repeat(`abcd`, 16), `debe9ff92275b8a138604889c18e5a4d6fdb70e5387e5765293dcba39c0c5732`},
+}
+
+// ******** Test functions ********
+
+func TestSM3(t *testing.T) {
+	h := New()
+
+	for _, v := range testVectors {
+		h.Reset()
+		_, _ = h.Write([]byte(v.message))
+
+		got := hex.EncodeToString(h.Sum(nil))
+		if got != v.expected {
+			t.Errorf(`Message '%s': got %s, expected %s`, v.message, got, v.expected)
+		}
+
+		h.Reset()
+		for _, b := range []byte(v.message) {
+			_, _ = h.Write([]byte{b})
+		}
+
+		got = hex.EncodeToString(h.Sum(nil))
+		if got != v.expected {
+			t.Errorf(`Message '%s', written byte by byte: got %s, expected %s`, v.message, got, v.expected)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package main
+
+import (
+	"encoding/hex"
+	"hash"
+	"hashvalue/hashfactory"
+	"hashvalue/stringhelper"
+)
+
+// ******** Private types ********
+
+// sourceType is the type of source part.
+type sourceType byte
+
+// These are the possible source types.
+const (
+	sourceTypeText sourceType = iota
+	sourceTypeHex
+	sourceTypeFile
+)
+
+// sourcePart is one part of the source that is to be hashed.
+// Normally, there is only one part. Tuple hashes may have several parts.
+type sourcePart struct {
+	// sourceType is the type of the source part.
+	sourceType sourceType
+
+	// text is the text from the command line. For file parts this is the file name.
+	text string
+
+	// data contains the bytes of text and hex parts.
+	data []byte
+}
+
+// ******** Private variables ********
+
+// sourceParts contains the source parts in the order they have been specified on the command line.
+var sourceParts []*sourcePart
+
+// ******** Private functions ********
+
+// sourcePartAdder returns a flag function that adds a source part of the given type.
+func sourcePartAdder(t sourceType) func(string) error {
+	return func(text string) error {
+		sourceParts = append(sourceParts, &sourcePart{sourceType: t, text: text})
+		return nil
+	}
+}
+
+// normalizeSourceParts normalizes the source parts.
+// Only hex parts are normalized. File names are *not* normalized as a file name may end or start with blanks.
+func normalizeSourceParts() {
+	for _, part := range sourceParts {
+		if part.sourceType == sourceTypeHex {
+			part.text = stringhelper.RemoveAllWhitespace(part.text)
+		}
+	}
+}
+
+// checkSourceParts checks the source parts and converts the text and hex parts to bytes.
+func checkSourceParts() int {
+	if len(sourceParts) == 0 {
+		return printUsageError(`Specify either 'source', 'hexsource' or 'file'`)
+	}
+
+	for _, part := range sourceParts {
+		switch part.sourceType {
+		case sourceTypeText:
+			if len(part.text) == 0 {
+				return printUsageErrorf(errFmtIsEmpty, `Source`)
+			}
+
+			part.data = stringhelper.UnsafeStringBytes(part.text)
+
+		case sourceTypeHex:
+			if len(part.text) == 0 {
+				return printUsageErrorf(errFmtIsEmpty, `Hex source`)
+			}
+
+			var err error
+			part.data, err = hex.DecodeString(part.text)
+			if err != nil {
+				return printUsageErrorf(`Invalid hex string: %v`, err)
+			}
+
+		case sourceTypeFile:
+			if len(part.text) == 0 {
+				return printUsageErrorf(errFmtIsEmpty, `File name`)
+			}
+		}
+	}
+
+	return rcOK
+}
+
+// checkSourceCount checks whether the hash function can process the number of source parts.
+// Only tuple hashes can process more than one source part.
+func checkSourceCount(hashFunc hash.Hash) int {
+	if len(sourceParts) > 1 {
+		if _, isTupleHash := hashFunc.(hashfactory.TupleHash); !isTupleHash {
+			return printUsageError(`Specify only one of 'source', 'hexsource' or 'file', except for tuple hashes`)
+		}
+	}
+
+	return rcOK
+}
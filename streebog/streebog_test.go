@@ -0,0 +1,108 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package streebog
+
+import (
+	"encoding/hex"
+	"hash"
+	"testing"
+)
+
+// ******** Private types ********
+
+// testVector is a test vector with a hexadecimal message and its expected hash values.
+type testVector struct {
+	name        string
+	message     string
+	expected256 string
+	expected512 string
+}
+
+// ******** Private variables ********
+
+// testVectors contains the examples M1 and M2 of RFC 6986, section 10.
+// The RFC shows messages and hash values in reversed byte order.
+// Here they are in the order in which the bytes are processed and printed.
+var testVectors = []testVector{
+	{
+		`M1`,
+		`303132333435363738393031323334353637383930313233343536373839303132333435363738393031323334353637383930313233343536373839303132`,
+		`9d151eefd8590b89daa6ba6cb74af9275dd051026bb149a452fd84e5e57b5500`,
+		`1b54d01a4af5b9d5cc3d86d68d285462b19abc2475222f35c085122be4ba1ffa00ad30f8767b3a82384c6574f024c311e2a481332b08ef7f41797891c1646f48`,
+	},
+	{
+		`M2`,
+		`d1e520e2e5f2f0e82c20d1f2f0e8e1eee6e820e2edf3f6e82c20e2e5fef2fa20f120eceef0ff20f1f2f0e5ebe0ece820ede020f5f0e0e1f0fbff20efebfaeafb20c8e3eef0e5e2fb`,
+		`9dd2fe4e90409e5da87f53976d7405b0c0cac628fc669a741d50063c557e8f50`,
+		`1e88e62226bfca6f9994f1f2d51569e0daf8475a3b0fe61a5300eee46d961376035fe83549ada2b8620fcd7c496ce5b33f0cb9dddc2b6460143b03dabac9fb28`,
+	},
+}
+
+// ******** Test functions ********
+
+func TestStreebog256(t *testing.T) {
+	for _, v := range testVectors {
+		checkTestVector(t, New256(), v.name, v.message, v.expected256)
+	}
+}
+
+func TestStreebog512(t *testing.T) {
+	for _, v := range testVectors {
+		checkTestVector(t, New512(), v.name, v.message, v.expected512)
+	}
+}
+
+// ******** Private functions ********
+
+// checkTestVector checks the hash value of a message in one call and byte by byte.
+func checkTestVector(t *testing.T, h hash.Hash, name string, hexMessage string, expected string) {
+	t.Helper()
+
+	message, err := hex.DecodeString(hexMessage)
+	if err != nil {
+		t.Fatalf(`Message %s is not valid hex: %v`, name, err)
+	}
+
+	_, _ = h.Write(message)
+
+	got := hex.EncodeToString(h.Sum(nil))
+	if got != expected {
+		t.Errorf(`Message %s: got %s, expected %s`, name, got, expected)
+	}
+
+	h.Reset()
+	for _, b := range message {
+		_, _ = h.Write([]byte{b})
+	}
+
+	got = hex.EncodeToString(h.Sum(nil))
+	if got != expected {
+		t.Errorf(`Message %s, written byte by byte: got %s, expected %s`, name, got, expected)
+	}
+}
@@ -0,0 +1,73 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package stringhelper
+
+// ******** Public functions ********
+
+// EditDistance returns the number of single character insertions, deletions, substitutions
+// and transpositions of adjacent characters that are needed to change a into b.
+// This is the optimal string alignment distance.
+func EditDistance(a string, b string) int {
+	ra := []rune(a)
+	rb := []rune(b)
+
+	// Only three rows of the distance matrix are needed.
+	previous2 := make([]int, len(rb)+1)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			current[j] = min(
+				previous[j]+1,      // Deletion
+				current[j-1]+1,     // Insertion
+				previous[j-1]+cost, // Substitution
+			)
+
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				current[j] = min(current[j], previous2[j-2]+1) // Transposition
+			}
+		}
+
+		previous2, previous, current = previous, current, previous2
+	}
+
+	return previous[len(rb)]
+}
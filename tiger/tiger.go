@@ -0,0 +1,287 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+// Package tiger implements the Tiger and Tiger2 hash functions by Ross Anderson and Eli Biham
+// (https://www.cs.technion.ac.il/~biham/Reports/Tiger/).
+//
+// Tiger and Tiger2 differ only in the padding.
+// The hash value consists of the three state words in little-endian byte order,
+// as in the NESSIE test vectors.
+package tiger
+
+import (
+	"encoding/binary"
+	"hash"
+)
+
+// ******** Public constants ********
+
+// Size is the size of a Tiger hash value in bytes.
+const Size = 24
+
+// BlockSize is the block size of Tiger in bytes.
+const BlockSize = 64
+
+// ******** Private constants ********
+
+// Padding bytes.
+const (
+	paddingTiger  = 0x01
+	paddingTiger2 = 0x80
+)
+
+// lengthSize is the size of the length field in the padding in bytes.
+const lengthSize = 8
+
+// Initial state.
+const (
+	initialA uint64 = 0x0123456789abcdef
+	initialB uint64 = 0xfedcba9876543210
+	initialC uint64 = 0xf096a5b4c3b2e187
+)
+
+// sBoxGenerationText is the text that is used as the message block for the generation of the S-boxes.
+const sBoxGenerationText = `Tiger - A Fast New Hash Function, by Ross Anderson and Eli Biham`
+
+// sBoxGenerationPasses is the number of passes of the S-box generation.
+const sBoxGenerationPasses = 5
+
+// ******** Private variables ********
+
+// sBoxes contains the four S-boxes t1, t2, t3 and t4.
+var sBoxes [4][256]uint64
+
+// ******** Public types ********
+
+// Digest implements the Tiger and Tiger2 hash functions.
+type Digest struct {
+	a, b, c      uint64
+	padding      byte
+	buffer       [BlockSize]byte
+	bufferedSize int
+	totalLength  uint64
+}
+
+// ******** Public functions ********
+
+// New creates a new Tiger hash function.
+func New() hash.Hash {
+	return newDigest(paddingTiger)
+}
+
+// New2 creates a new Tiger2 hash function.
+func New2() hash.Hash {
+	return newDigest(paddingTiger2)
+}
+
+// -------- Digest methods --------
+
+// Write adds more data to the running hash.
+func (d *Digest) Write(p []byte) (int, error) {
+	n := len(p)
+	d.totalLength += uint64(n)
+
+	if d.bufferedSize != 0 {
+		copied := copy(d.buffer[d.bufferedSize:], p)
+		d.bufferedSize += copied
+		p = p[copied:]
+
+		if d.bufferedSize < BlockSize {
+			return n, nil
+		}
+
+		d.block(d.buffer[:])
+		d.bufferedSize = 0
+	}
+
+	for len(p) >= BlockSize {
+		d.block(p)
+		p = p[BlockSize:]
+	}
+
+	d.bufferedSize = copy(d.buffer[:], p)
+
+	return n, nil
+}
+
+// Sum appends the current hash value to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (d *Digest) Sum(b []byte) []byte {
+	// Work on a copy, so that the caller can keep writing.
+	c := *d
+
+	// Padding: The padding byte, zero bytes and the length in bits as a little-endian 64 bit value.
+	var padding [2 * BlockSize]byte
+	padding[0] = c.padding
+
+	padLen := BlockSize - lengthSize - c.bufferedSize
+	if padLen <= 0 {
+		padLen += BlockSize
+	}
+
+	binary.LittleEndian.PutUint64(padding[padLen:], c.totalLength<<3)
+	_, _ = c.Write(padding[:padLen+lengthSize])
+
+	b = binary.LittleEndian.AppendUint64(b, c.a)
+	b = binary.LittleEndian.AppendUint64(b, c.b)
+
+	return binary.LittleEndian.AppendUint64(b, c.c)
+}
+
+// Reset resets the hash to its initial state.
+func (d *Digest) Reset() {
+	d.a = initialA
+	d.b = initialB
+	d.c = initialC
+	d.bufferedSize = 0
+	d.totalLength = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (d *Digest) Size() int {
+	return Size
+}
+
+// BlockSize returns the hash's underlying block size.
+func (d *Digest) BlockSize() int {
+	return BlockSize
+}
+
+// block processes one block.
+func (d *Digest) block(p []byte) {
+	d.a, d.b, d.c = compress(p, d.a, d.b, d.c)
+}
+
+// ******** Private functions ********
+
+// init is the package initialization function.
+// It generates the S-boxes with the algorithm from the Tiger paper.
+func init() {
+	for i := range sBoxes {
+		for j := range sBoxes[i] {
+			sBoxes[i][j] = uint64(j) * 0x0101010101010101
+		}
+	}
+
+	state := [3]uint64{initialA, initialB, initialC}
+	text := []byte(sBoxGenerationText)
+
+	abc := 2
+	for range sBoxGenerationPasses {
+		for i := range 256 {
+			for sb := range sBoxes {
+				abc++
+				if abc == 3 {
+					abc = 0
+					state[0], state[1], state[2] = compress(text, state[0], state[1], state[2])
+				}
+
+				// Swap byte col of entry i with byte col of the entry that the state selects.
+				for col := range 8 {
+					shift := 8 * col
+					j := byte(state[abc] >> shift)
+					mask := uint64(0xff) << shift
+					bi := sBoxes[sb][i] & mask
+					bj := sBoxes[sb][j] & mask
+					sBoxes[sb][i] = sBoxes[sb][i]&^mask | bj
+					sBoxes[sb][j] = sBoxes[sb][j]&^mask | bi
+				}
+			}
+		}
+	}
+}
+
+// newDigest creates a new Tiger hash function with the given padding byte.
+func newDigest(padding byte) *Digest {
+	d := &Digest{padding: padding}
+	d.Reset()
+
+	return d
+}
+
+// compress is the compression function of Tiger.
+func compress(p []byte, a, b, c uint64) (uint64, uint64, uint64) {
+	var x [8]uint64
+	for i := range x {
+		x[i] = binary.LittleEndian.Uint64(p[8*i:])
+	}
+
+	aa, bb, cc := a, b, c
+
+	a, b, c = pass(a, b, c, &x, 5)
+	keySchedule(&x)
+	c, a, b = pass(c, a, b, &x, 7)
+	keySchedule(&x)
+	b, c, a = pass(b, c, a, &x, 9)
+
+	return a ^ aa, b - bb, c + cc
+}
+
+// pass processes all eight words of a block with the given multiplier.
+func pass(a, b, c uint64, x *[8]uint64, mul uint64) (uint64, uint64, uint64) {
+	a, b, c = round(a, b, c, x[0], mul)
+	b, c, a = round(b, c, a, x[1], mul)
+	c, a, b = round(c, a, b, x[2], mul)
+	a, b, c = round(a, b, c, x[3], mul)
+	b, c, a = round(b, c, a, x[4], mul)
+	c, a, b = round(c, a, b, x[5], mul)
+	a, b, c = round(a, b, c, x[6], mul)
+	b, c, a = round(b, c, a, x[7], mul)
+
+	return a, b, c
+}
+
+// round is the round function of Tiger.
+func round(a, b, c, x, mul uint64) (uint64, uint64, uint64) {
+	c ^= x
+	a -= sBoxes[0][byte(c)] ^ sBoxes[1][byte(c>>16)] ^ sBoxes[2][byte(c>>32)] ^ sBoxes[3][byte(c>>48)]
+	b += sBoxes[3][byte(c>>8)] ^ sBoxes[2][byte(c>>24)] ^ sBoxes[1][byte(c>>40)] ^ sBoxes[0][byte(c>>56)]
+	b *= mul
+
+	return a, b, c
+}
+
+// keySchedule modifies the message words between the passes.
+func keySchedule(x *[8]uint64) {
+	x[0] -= x[7] ^ 0xa5a5a5a5a5a5a5a5
+	x[1] ^= x[0]
+	x[2] += x[1]
+	x[3] -= x[2] ^ (^x[1] << 19)
+	x[4] ^= x[3]
+	x[5] += x[4]
+	x[6] -= x[5] ^ (^x[4] >> 23)
+	x[7] ^= x[6]
+	x[0] += x[7]
+	x[1] -= x[0] ^ (^x[7] << 19)
+	x[2] ^= x[1]
+	x[3] += x[2]
+	x[4] -= x[3] ^ (^x[2] >> 23)
+	x[5] ^= x[4]
+	x[6] += x[5]
+	x[7] -= x[6] ^ 0x0123456789abcdef
+}
@@ -0,0 +1,261 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+// Package whirlpool implements the Whirlpool hash function by Vincent Rijmen and Paulo Barreto
+// in its final version from 2003, as standardized in ISO/IEC 10118-3.
+package whirlpool
+
+import (
+	"encoding/binary"
+	"hash"
+	"math/bits"
+)
+
+// ******** Public constants ********
+
+// Size is the size of a Whirlpool hash value in bytes.
+const Size = 64
+
+// BlockSize is the block size of Whirlpool in bytes.
+const BlockSize = 64
+
+// ******** Private constants ********
+
+// rounds is the number of rounds of the block cipher.
+const rounds = 10
+
+// lengthSize is the size of the length field in the padding in bytes.
+const lengthSize = 32
+
+// reductionPolynomial is the polynomial x^8 + x^4 + x^3 + x^2 + 1 of the field GF(2^8).
+const reductionPolynomial = 0x11d
+
+// ******** Private variables ********
+
+// The S-box is built from the mini boxes e, its inverse and r.
+var (
+	miniBoxE = [16]byte{0x1, 0xb, 0x9, 0xc, 0xd, 0x6, 0xf, 0x3, 0xe, 0x8, 0x7, 0x4, 0xa, 0x2, 0x5, 0x0}
+	miniBoxR = [16]byte{0x7, 0xc, 0xb, 0xd, 0xe, 0x4, 0x9, 0xf, 0x6, 0x3, 0x8, 0xa, 0x2, 0x5, 0x1, 0x0}
+)
+
+// mdsRow is the first row of the circulant MDS matrix.
+var mdsRow = [8]byte{1, 1, 4, 1, 8, 5, 2, 9}
+
+// table contains the combined S-box and MDS matrix multiplication for each byte value.
+// Each row of the state is a 64 bit value with the first byte in the most significant position.
+var table [256]uint64
+
+// roundConstants contains the round constants of the key schedule.
+var roundConstants [rounds + 1]uint64
+
+// ******** Public types ********
+
+// Digest implements the Whirlpool hash function.
+type Digest struct {
+	state        [8]uint64
+	buffer       [BlockSize]byte
+	bufferedSize int
+	totalLength  uint64
+}
+
+// ******** Public functions ********
+
+// New creates a new Whirlpool hash function.
+func New() hash.Hash {
+	return &Digest{}
+}
+
+// -------- Digest methods --------
+
+// Write adds more data to the running hash.
+func (d *Digest) Write(p []byte) (int, error) {
+	n := len(p)
+	d.totalLength += uint64(n)
+
+	if d.bufferedSize != 0 {
+		copied := copy(d.buffer[d.bufferedSize:], p)
+		d.bufferedSize += copied
+		p = p[copied:]
+
+		if d.bufferedSize < BlockSize {
+			return n, nil
+		}
+
+		d.block(d.buffer[:])
+		d.bufferedSize = 0
+	}
+
+	for len(p) >= BlockSize {
+		d.block(p)
+		p = p[BlockSize:]
+	}
+
+	d.bufferedSize = copy(d.buffer[:], p)
+
+	return n, nil
+}
+
+// Sum appends the current hash value to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (d *Digest) Sum(b []byte) []byte {
+	// Work on a copy, so that the caller can keep writing.
+	c := *d
+
+	// Padding: A 1 bit, zero bits and the length in bits as a 256 bit value.
+	var padding [2 * BlockSize]byte
+	padding[0] = 0x80
+
+	padLen := BlockSize - lengthSize - c.bufferedSize
+	if padLen <= 0 {
+		padLen += BlockSize
+	}
+
+	// The length in bits is never longer than 67 bits, so the upper bits of the length field are 0.
+	binary.BigEndian.PutUint64(padding[padLen+lengthSize-16:], c.totalLength>>61)
+	binary.BigEndian.PutUint64(padding[padLen+lengthSize-8:], c.totalLength<<3)
+	_, _ = c.Write(padding[:padLen+lengthSize])
+
+	for _, row := range c.state {
+		b = binary.BigEndian.AppendUint64(b, row)
+	}
+
+	return b
+}
+
+// Reset resets the hash to its initial state.
+func (d *Digest) Reset() {
+	*d = Digest{}
+}
+
+// Size returns the number of bytes Sum will return.
+func (d *Digest) Size() int {
+	return Size
+}
+
+// BlockSize returns the hash's underlying block size.
+func (d *Digest) BlockSize() int {
+	return BlockSize
+}
+
+// block processes one block with the Miyaguchi-Preneel construction.
+func (d *Digest) block(p []byte) {
+	var key, state, message [8]uint64
+
+	for i := range message {
+		message[i] = binary.BigEndian.Uint64(p[8*i:])
+	}
+
+	key = d.state
+	for i := range state {
+		state[i] = message[i] ^ key[i]
+	}
+
+	for r := 1; r <= rounds; r++ {
+		key = roundFunction(&key)
+		key[0] ^= roundConstants[r]
+
+		state = roundFunction(&state)
+		for i := range state {
+			state[i] ^= key[i]
+		}
+	}
+
+	for i := range d.state {
+		d.state[i] ^= state[i] ^ message[i]
+	}
+}
+
+// ******** Private functions ********
+
+// init is the package initialization function.
+// It calculates the tables from the mini boxes and the MDS matrix.
+func init() {
+	var sBox [256]byte
+
+	var miniBoxEInverse [16]byte
+	for i, v := range miniBoxE {
+		miniBoxEInverse[v] = byte(i)
+	}
+
+	for u := range sBox {
+		left := miniBoxE[u>>4]
+		right := miniBoxEInverse[u&0x0f]
+		mixed := miniBoxR[left^right]
+		sBox[u] = miniBoxE[left^mixed]<<4 | miniBoxEInverse[right^mixed]
+	}
+
+	for u, s := range sBox {
+		var row uint64
+		for _, factor := range mdsRow {
+			row = row<<8 | uint64(multiply(s, factor))
+		}
+
+		table[u] = row
+	}
+
+	for r := 1; r <= rounds; r++ {
+		roundConstants[r] = binary.BigEndian.Uint64(sBox[8*(r-1):])
+	}
+}
+
+// roundFunction applies the substitution, the cyclic permutation and the linear diffusion layer to a state.
+// Byte j of row i of the result is calculated from byte j of row i-j of the input.
+func roundFunction(a *[8]uint64) [8]uint64 {
+	var result [8]uint64
+
+	for i := range result {
+		var row uint64
+		for j := 0; j < 8; j++ {
+			b := byte(a[(i-j)&7] >> (56 - 8*j))
+			row ^= bits.RotateLeft64(table[b], -8*j)
+		}
+
+		result[i] = row
+	}
+
+	return result
+}
+
+// multiply multiplies two elements of the field GF(2^8).
+func multiply(a byte, b byte) byte {
+	var result uint
+	x := uint(a)
+
+	for y := b; y != 0; y >>= 1 {
+		if y&1 != 0 {
+			result ^= x
+		}
+
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= reductionPolynomial
+		}
+	}
+
+	return byte(result)
+}
@@ -0,0 +1,348 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package xxhash
+
+import (
+	"math/bits"
+)
+
+// ******** Public constants ********
+
+// Size128 is the size of an XXH3 128 bit hash value in bytes.
+const Size128 = 16
+
+// ******** Private constants ********
+
+// Sizes of XXH3.
+const (
+	// stripeLen3 is the length of a stripe of XXH3 in bytes.
+	stripeLen3 = 64
+
+	// secretSize is the size of the default secret in bytes.
+	secretSize = 192
+
+	// secretConsumeRate is the number of secret bytes by which the secret is advanced for each stripe.
+	secretConsumeRate = 8
+
+	// stripesPerBlock is the number of stripes in a block.
+	stripesPerBlock = (secretSize - stripeLen3) / secretConsumeRate
+
+	// bufferSize3 is the size of the input buffer. It is a multiple of the stripe length.
+	bufferSize3 = 4 * stripeLen3
+
+	// midSizeMax is the maximum input length that is hashed without accumulators.
+	midSizeMax = 240
+)
+
+// Secret offsets of XXH3.
+const (
+	secretLastAccStart   = 7
+	secretMergeAccsStart = 11
+	midSizeStartOffset   = 3
+	midSizeLastOffset    = 17
+)
+
+// These are the multipliers of the XXH3 mixing functions.
+const (
+	primeMx1 uint64 = 0x165667919e3779f9
+	primeMx2 uint64 = 0x9fb21c651e98df25
+)
+
+// ******** Private variables ********
+
+// defaultSecret is the default secret of XXH3.
+var defaultSecret = [secretSize]byte{
+	0xb8, 0xfe, 0x6c, 0x39, 0x23, 0xa4, 0x4b, 0xbe, 0x7c, 0x01, 0x81, 0x2c, 0xf7, 0x21, 0xad, 0x1c,
+	0xde, 0xd4, 0x6d, 0xe9, 0x83, 0x90, 0x97, 0xdb, 0x72, 0x40, 0xa4, 0xa4, 0xb7, 0xb3, 0x67, 0x1f,
+	0xcb, 0x79, 0xe6, 0x4e, 0xcc, 0xc0, 0xe5, 0x78, 0x82, 0x5a, 0xd0, 0x7d, 0xcc, 0xff, 0x72, 0x21,
+	0xb8, 0x08, 0x46, 0x74, 0xf7, 0x43, 0x24, 0x8e, 0xe0, 0x35, 0x90, 0xe6, 0x81, 0x3a, 0x26, 0x4c,
+	0x3c, 0x28, 0x52, 0xbb, 0x91, 0xc3, 0x00, 0xcb, 0x88, 0xd0, 0x65, 0x8b, 0x1b, 0x53, 0x2e, 0xa3,
+	0x71, 0x64, 0x48, 0x97, 0xa2, 0x0d, 0xf9, 0x4e, 0x38, 0x19, 0xef, 0x46, 0xa9, 0xde, 0xac, 0xd8,
+	0xa8, 0xfa, 0x76, 0x3f, 0xe3, 0x9c, 0x34, 0x3f, 0xf9, 0xdc, 0xbb, 0xc7, 0xc7, 0x0b, 0x4f, 0x1d,
+	0x8a, 0x51, 0xe0, 0x4b, 0xcd, 0xb4, 0x59, 0x31, 0xc8, 0x9f, 0x7e, 0xc9, 0xd9, 0x78, 0x73, 0x64,
+	0xea, 0xc5, 0xac, 0x83, 0x34, 0xd3, 0xeb, 0xc3, 0xc5, 0x81, 0xa0, 0xff, 0xfa, 0x13, 0x63, 0xeb,
+	0x17, 0x0d, 0xdd, 0x51, 0xb7, 0xf0, 0xda, 0x49, 0xd3, 0x16, 0x55, 0x26, 0x29, 0xd4, 0x68, 0x9e,
+	0x2b, 0x16, 0xbe, 0x58, 0x7d, 0x47, 0xa1, 0xfc, 0x8f, 0xf8, 0xb8, 0xd1, 0x7a, 0xd0, 0x31, 0xce,
+	0x45, 0xcb, 0x3a, 0x8f, 0x95, 0x16, 0x04, 0x28, 0xaf, 0xd7, 0xfb, 0xca, 0xbb, 0x4b, 0x40, 0x7e,
+}
+
+// initialAcc is the initial value of the accumulators.
+var initialAcc = [8]uint64{
+	uint64(prime32_3), prime64_1, prime64_2, prime64_3,
+	prime64_4, uint64(prime32_2), prime64_5, uint64(prime32_1),
+}
+
+// ******** Public types ********
+
+// Digest3 implements the XXH3 hash function with an output of 64 or 128 bits.
+type Digest3 struct {
+	seed   uint64
+	secret *[secretSize]byte
+	size   int
+
+	acc            [8]uint64
+	stripesInBlock int
+	buffer         [bufferSize3]byte
+	bufferedSize   int
+	previous       [stripeLen3]byte
+	totalLength    uint64
+}
+
+// ******** Public functions ********
+
+// New3 creates a new XXH3 hash function with a 64 bit output and the given seed.
+func New3(seed uint64) *Digest3 {
+	return newDigest3(seed, Size64)
+}
+
+// New128 creates a new XXH3 hash function with a 128 bit output (XXH128) and the given seed.
+func New128(seed uint64) *Digest3 {
+	return newDigest3(seed, Size128)
+}
+
+// -------- Digest3 methods --------
+
+// Write adds more data to the running hash.
+func (d *Digest3) Write(p []byte) (int, error) {
+	n := len(p)
+	d.totalLength += uint64(n)
+
+	// The buffer is only processed when more data follows, as the last stripe is processed differently.
+	if d.bufferedSize+n <= bufferSize3 {
+		d.bufferedSize += copy(d.buffer[d.bufferedSize:], p)
+		return n, nil
+	}
+
+	copied := copy(d.buffer[d.bufferedSize:], p)
+	p = p[copied:]
+	d.consumeStripes(d.buffer[:])
+	last := d.buffer[bufferSize3-stripeLen3:]
+
+	for len(p) > bufferSize3 {
+		d.consumeStripes(p[:bufferSize3])
+		last = p[bufferSize3-stripeLen3 : bufferSize3]
+		p = p[bufferSize3:]
+	}
+
+	copy(d.previous[:], last)
+	d.bufferedSize = copy(d.buffer[:], p)
+
+	return n, nil
+}
+
+// Sum64 returns the current 64 bit hash value.
+// For an XXH128 hash function it returns the lower half of the hash value.
+func (d *Digest3) Sum64() uint64 {
+	_, lo := d.sum128()
+
+	return lo
+}
+
+// Sum appends the current hash value in big-endian byte order to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (d *Digest3) Sum(b []byte) []byte {
+	hi, lo := d.sum128()
+	if d.size == Size128 {
+		b = appendUint64(b, hi)
+	}
+
+	return appendUint64(b, lo)
+}
+
+// Reset resets the hash to its initial state.
+func (d *Digest3) Reset() {
+	d.acc = initialAcc
+	d.stripesInBlock = 0
+	d.bufferedSize = 0
+	d.totalLength = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (d *Digest3) Size() int {
+	return d.size
+}
+
+// BlockSize returns the hash's underlying block size.
+func (d *Digest3) BlockSize() int {
+	return stripeLen3
+}
+
+// sum128 calculates the hash value.
+// For a 64 bit hash function the upper half is 0.
+func (d *Digest3) sum128() (uint64, uint64) {
+	if d.totalLength <= midSizeMax {
+		input := d.buffer[:d.bufferedSize]
+		if d.size == Size128 {
+			return hash128Short(input, &defaultSecret, d.seed)
+		}
+
+		return 0, hash64Short(input, &defaultSecret, d.seed)
+	}
+
+	acc := d.acc
+	stripesInBlock := d.stripesInBlock
+	secret := d.secret[:]
+
+	// Process all stripes of the buffer except the last one.
+	input := d.buffer[:d.bufferedSize]
+	for range (len(input) - 1) / stripeLen3 {
+		accumulate512(&acc, input, secret[stripesInBlock*secretConsumeRate:])
+		stripesInBlock++
+		input = input[stripeLen3:]
+	}
+
+	// The last stripe consists of the last 64 bytes of the data.
+	var lastStripe [stripeLen3]byte
+	if d.bufferedSize >= stripeLen3 {
+		copy(lastStripe[:], d.buffer[d.bufferedSize-stripeLen3:d.bufferedSize])
+	} else {
+		n := copy(lastStripe[:], d.previous[d.bufferedSize:])
+		copy(lastStripe[n:], d.buffer[:d.bufferedSize])
+	}
+
+	accumulate512(&acc, lastStripe[:], secret[secretSize-stripeLen3-secretLastAccStart:])
+
+	lo := mergeAccs(&acc, secret[secretMergeAccsStart:], d.totalLength*prime64_1)
+	if d.size != Size128 {
+		return 0, lo
+	}
+
+	hi := mergeAccs(&acc, secret[secretSize-stripeLen3-secretMergeAccsStart:], ^(d.totalLength * prime64_2))
+
+	return hi, lo
+}
+
+// consumeStripes processes all stripes in p.
+// The accumulators are scrambled at the end of each block.
+func (d *Digest3) consumeStripes(p []byte) {
+	secret := d.secret[:]
+
+	for len(p) != 0 {
+		accumulate512(&d.acc, p, secret[d.stripesInBlock*secretConsumeRate:])
+		p = p[stripeLen3:]
+
+		d.stripesInBlock++
+		if d.stripesInBlock == stripesPerBlock {
+			scrambleAcc(&d.acc, secret[secretSize-stripeLen3:])
+			d.stripesInBlock = 0
+		}
+	}
+}
+
+// ******** Private functions ********
+
+// newDigest3 creates a new XXH3 hash function.
+// Long inputs are hashed with a secret that is derived from the seed.
+func newDigest3(seed uint64, size int) *Digest3 {
+	d := &Digest3{seed: seed, size: size, secret: &defaultSecret}
+
+	if seed != 0 {
+		d.secret = new([secretSize]byte)
+		for i := 0; i < secretSize; i += 16 {
+			putUint64(d.secret[i:], readUint64(defaultSecret[i:])+seed)
+			putUint64(d.secret[i+8:], readUint64(defaultSecret[i+8:])-seed)
+		}
+	}
+
+	d.Reset()
+
+	return d
+}
+
+// accumulate512 accumulates one stripe.
+func accumulate512(acc *[8]uint64, input []byte, secret []byte) {
+	for i := range acc {
+		dataVal := readUint64(input[8*i:])
+		dataKey := dataVal ^ readUint64(secret[8*i:])
+		acc[i^1] += dataVal
+		acc[i] += uint64(uint32(dataKey)) * (dataKey >> 32)
+	}
+}
+
+// scrambleAcc scrambles the accumulators at the end of a block.
+func scrambleAcc(acc *[8]uint64, secret []byte) {
+	for i := range acc {
+		a := acc[i]
+		a ^= a >> 47
+		a ^= readUint64(secret[8*i:])
+		a *= uint64(prime32_1)
+		acc[i] = a
+	}
+}
+
+// mergeAccs merges the accumulators into a 64 bit value.
+func mergeAccs(acc *[8]uint64, secret []byte, start uint64) uint64 {
+	result := start
+	for i := 0; i < 4; i++ {
+		result += mul128Fold64(
+			acc[2*i]^readUint64(secret[16*i:]),
+			acc[2*i+1]^readUint64(secret[16*i+8:]),
+		)
+	}
+
+	return xxh3Avalanche(result)
+}
+
+// xxh3Avalanche is the final mix of XXH3.
+func xxh3Avalanche(h uint64) uint64 {
+	h ^= h >> 37
+	h *= primeMx1
+	h ^= h >> 32
+
+	return h
+}
+
+// rrmxmx is the final mix of XXH3 for inputs with 4 to 8 bytes.
+func rrmxmx(h uint64, length uint64) uint64 {
+	h ^= bits.RotateLeft64(h, 49) ^ bits.RotateLeft64(h, 24)
+	h *= primeMx2
+	h ^= (h >> 35) + length
+	h *= primeMx2
+	h ^= h >> 28
+
+	return h
+}
+
+// mix16 mixes 16 bytes of input with 16 bytes of secret.
+func mix16(input []byte, secret []byte, seed uint64) uint64 {
+	return mul128Fold64(
+		readUint64(input)^(readUint64(secret)+seed),
+		readUint64(input[8:])^(readUint64(secret[8:])-seed),
+	)
+}
+
+// mix32 mixes 2 times 16 bytes of input with 32 bytes of secret into a 128 bit accumulator.
+func mix32(accHi uint64, accLo uint64, input1 []byte, input2 []byte, secret []byte, seed uint64) (uint64, uint64) {
+	accLo += mix16(input1, secret, seed)
+	accLo ^= readUint64(input2) + readUint64(input2[8:])
+	accHi += mix16(input2, secret[16:], seed)
+	accHi ^= readUint64(input1) + readUint64(input1[8:])
+
+	return accHi, accLo
+}
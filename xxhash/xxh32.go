@@ -0,0 +1,173 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package xxhash
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// ******** Public constants ********
+
+// Size32 is the size of an XXH32 hash value in bytes.
+const Size32 = 4
+
+// ******** Private constants ********
+
+// stripeLen32 is the length of a stripe of XXH32 in bytes.
+const stripeLen32 = 16
+
+// ******** Public types ********
+
+// Digest32 implements the XXH32 hash function.
+type Digest32 struct {
+	seed         uint32
+	v            [4]uint32
+	buffer       [stripeLen32]byte
+	bufferedSize int
+	totalLength  uint64
+}
+
+// ******** Public functions ********
+
+// New32 creates a new XXH32 hash function with the given seed.
+func New32(seed uint32) *Digest32 {
+	d := &Digest32{seed: seed}
+	d.Reset()
+
+	return d
+}
+
+// -------- Digest32 methods --------
+
+// Write adds more data to the running hash.
+func (d *Digest32) Write(p []byte) (int, error) {
+	n := len(p)
+	d.totalLength += uint64(n)
+
+	if d.bufferedSize+n < stripeLen32 {
+		d.bufferedSize += copy(d.buffer[d.bufferedSize:], p)
+		return n, nil
+	}
+
+	if d.bufferedSize != 0 {
+		copied := copy(d.buffer[d.bufferedSize:], p)
+		d.stripe(d.buffer[:])
+		p = p[copied:]
+		d.bufferedSize = 0
+	}
+
+	for len(p) >= stripeLen32 {
+		d.stripe(p)
+		p = p[stripeLen32:]
+	}
+
+	d.bufferedSize = copy(d.buffer[:], p)
+
+	return n, nil
+}
+
+// Sum32 returns the current hash value.
+func (d *Digest32) Sum32() uint32 {
+	var h uint32
+	if d.totalLength >= stripeLen32 {
+		h = bits.RotateLeft32(d.v[0], 1) +
+			bits.RotateLeft32(d.v[1], 7) +
+			bits.RotateLeft32(d.v[2], 12) +
+			bits.RotateLeft32(d.v[3], 18)
+	} else {
+		h = d.seed + prime32_5
+	}
+
+	h += uint32(d.totalLength)
+
+	p := d.buffer[:d.bufferedSize]
+	for len(p) >= 4 {
+		h += readUint32(p) * prime32_3
+		h = bits.RotateLeft32(h, 17) * prime32_4
+		p = p[4:]
+	}
+
+	for _, b := range p {
+		h += uint32(b) * prime32_5
+		h = bits.RotateLeft32(h, 11) * prime32_1
+	}
+
+	h ^= h >> 15
+	h *= prime32_2
+	h ^= h >> 13
+	h *= prime32_3
+	h ^= h >> 16
+
+	return h
+}
+
+// Sum appends the current hash value in big-endian byte order to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (d *Digest32) Sum(b []byte) []byte {
+	return binary.BigEndian.AppendUint32(b, d.Sum32())
+}
+
+// Reset resets the hash to its initial state.
+func (d *Digest32) Reset() {
+	d.v[0] = d.seed + prime32_1 + prime32_2
+	d.v[1] = d.seed + prime32_2
+	d.v[2] = d.seed
+	d.v[3] = d.seed - prime32_1
+	d.bufferedSize = 0
+	d.totalLength = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (d *Digest32) Size() int {
+	return Size32
+}
+
+// BlockSize returns the hash's underlying block size.
+func (d *Digest32) BlockSize() int {
+	return stripeLen32
+}
+
+// stripe processes one stripe.
+func (d *Digest32) stripe(p []byte) {
+	d.v[0] = round32(d.v[0], readUint32(p))
+	d.v[1] = round32(d.v[1], readUint32(p[4:]))
+	d.v[2] = round32(d.v[2], readUint32(p[8:]))
+	d.v[3] = round32(d.v[3], readUint32(p[12:]))
+}
+
+// ******** Private functions ********
+
+// round32 is the round function of XXH32.
+func round32(acc uint32, input uint32) uint32 {
+	acc += input * prime32_2
+	acc = bits.RotateLeft32(acc, 13)
+
+	return acc * prime32_1
+}
@@ -0,0 +1,186 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package xxhash
+
+import (
+	"math/bits"
+)
+
+// ******** Private functions ********
+
+// -------- 64 bit short inputs --------
+
+// hash64Short calculates the 64 bit XXH3 hash value of an input with at most midSizeMax bytes.
+func hash64Short(input []byte, secret *[secretSize]byte, seed uint64) uint64 {
+	length := uint64(len(input))
+
+	switch {
+	case length == 0:
+		return xxh64Avalanche(seed ^ readUint64(secret[56:]) ^ readUint64(secret[64:]))
+
+	case length <= 3:
+		bitFlip := uint64(readUint32(secret[0:])^readUint32(secret[4:])) + seed
+		return xxh64Avalanche(uint64(combine1To3(input)) ^ bitFlip)
+
+	case length <= 8:
+		seed ^= uint64(bits.ReverseBytes32(uint32(seed))) << 32
+		bitFlip := (readUint64(secret[8:]) ^ readUint64(secret[16:])) - seed
+		input64 := uint64(readUint32(input[length-4:])) | uint64(readUint32(input))<<32
+		return rrmxmx(input64^bitFlip, length)
+
+	case length <= 16:
+		bitFlip1 := (readUint64(secret[24:]) ^ readUint64(secret[32:])) + seed
+		bitFlip2 := (readUint64(secret[40:]) ^ readUint64(secret[48:])) - seed
+		inputLo := readUint64(input) ^ bitFlip1
+		inputHi := readUint64(input[length-8:]) ^ bitFlip2
+		acc := length + bits.ReverseBytes64(inputLo) + inputHi + mul128Fold64(inputLo, inputHi)
+		return xxh3Avalanche(acc)
+
+	case length <= 128:
+		acc := length * prime64_1
+		for i := (length - 1) / 32; ; i-- {
+			acc += mix16(input[16*i:], secret[32*i:], seed)
+			acc += mix16(input[length-16*(i+1):], secret[32*i+16:], seed)
+			if i == 0 {
+				break
+			}
+		}
+
+		return xxh3Avalanche(acc)
+
+	default:
+		acc := length * prime64_1
+		rounds := length / 16
+		for i := uint64(0); i < 8; i++ {
+			acc += mix16(input[16*i:], secret[16*i:], seed)
+		}
+
+		acc = xxh3Avalanche(acc)
+		for i := uint64(8); i < rounds; i++ {
+			acc += mix16(input[16*i:], secret[16*(i-8)+midSizeStartOffset:], seed)
+		}
+
+		acc += mix16(input[length-16:], secret[136-midSizeLastOffset:], seed)
+
+		return xxh3Avalanche(acc)
+	}
+}
+
+// -------- 128 bit short inputs --------
+
+// hash128Short calculates the 128 bit XXH3 hash value of an input with at most midSizeMax bytes.
+// It returns the upper and the lower half of the hash value.
+func hash128Short(input []byte, secret *[secretSize]byte, seed uint64) (uint64, uint64) {
+	length := uint64(len(input))
+
+	switch {
+	case length == 0:
+		return xxh64Avalanche(seed ^ readUint64(secret[80:]) ^ readUint64(secret[88:])),
+			xxh64Avalanche(seed ^ readUint64(secret[64:]) ^ readUint64(secret[72:]))
+
+	case length <= 3:
+		combinedLo := combine1To3(input)
+		combinedHi := bits.RotateLeft32(bits.ReverseBytes32(combinedLo), 13)
+		bitFlipLo := uint64(readUint32(secret[0:])^readUint32(secret[4:])) + seed
+		bitFlipHi := uint64(readUint32(secret[8:])^readUint32(secret[12:])) - seed
+		return xxh64Avalanche(uint64(combinedHi) ^ bitFlipHi), xxh64Avalanche(uint64(combinedLo) ^ bitFlipLo)
+
+	case length <= 8:
+		seed ^= uint64(bits.ReverseBytes32(uint32(seed))) << 32
+		input64 := uint64(readUint32(input)) | uint64(readUint32(input[length-4:]))<<32
+		bitFlip := (readUint64(secret[16:]) ^ readUint64(secret[24:])) + seed
+		hi, lo := bits.Mul64(input64^bitFlip, prime64_1+(length<<2))
+		hi += lo << 1
+		lo ^= hi >> 3
+		lo ^= lo >> 35
+		lo *= primeMx2
+		lo ^= lo >> 28
+		return xxh3Avalanche(hi), lo
+
+	case length <= 16:
+		bitFlipLo := (readUint64(secret[32:]) ^ readUint64(secret[40:])) - seed
+		bitFlipHi := (readUint64(secret[48:]) ^ readUint64(secret[56:])) + seed
+		inputLo := readUint64(input)
+		inputHi := readUint64(input[length-8:])
+		mHi, mLo := bits.Mul64(inputLo^inputHi^bitFlipLo, prime64_1)
+		mLo += (length - 1) << 54
+		inputHi ^= bitFlipHi
+		mHi += inputHi + uint64(uint32(inputHi))*uint64(prime32_2-1)
+		mLo ^= bits.ReverseBytes64(mHi)
+		hHi, hLo := bits.Mul64(mLo, prime64_2)
+		hHi += mHi * prime64_2
+		return xxh3Avalanche(hHi), xxh3Avalanche(hLo)
+
+	case length <= 128:
+		accHi, accLo := uint64(0), length*prime64_1
+		for i := (length - 1) / 32; ; i-- {
+			accHi, accLo = mix32(accHi, accLo, input[16*i:], input[length-16*(i+1):], secret[32*i:], seed)
+			if i == 0 {
+				break
+			}
+		}
+
+		return finish128(accHi, accLo, length, seed)
+
+	default:
+		accHi, accLo := uint64(0), length*prime64_1
+		rounds := length / 32
+		for i := uint64(0); i < 4; i++ {
+			accHi, accLo = mix32(accHi, accLo, input[32*i:], input[32*i+16:], secret[32*i:], seed)
+		}
+
+		accHi = xxh3Avalanche(accHi)
+		accLo = xxh3Avalanche(accLo)
+		for i := uint64(4); i < rounds; i++ {
+			accHi, accLo = mix32(accHi, accLo, input[32*i:], input[32*i+16:], secret[32*(i-4)+midSizeStartOffset:], seed)
+		}
+
+		accHi, accLo = mix32(accHi, accLo, input[length-16:], input[length-32:], secret[136-midSizeLastOffset-16:], -seed)
+
+		return finish128(accHi, accLo, length, seed)
+	}
+}
+
+// finish128 calculates the 128 bit hash value from the 128 bit accumulator of medium sized inputs.
+func finish128(accHi uint64, accLo uint64, length uint64, seed uint64) (uint64, uint64) {
+	lo := accLo + accHi
+	hi := accLo*prime64_1 + accHi*prime64_4 + (length-seed)*prime64_2
+
+	return -xxh3Avalanche(hi), xxh3Avalanche(lo)
+}
+
+// combine1To3 combines an input with 1 to 3 bytes into a 32 bit value.
+func combine1To3(input []byte) uint32 {
+	length := len(input)
+	c1 := uint32(input[0])
+	c2 := uint32(input[length>>1])
+	c3 := uint32(input[length-1])
+
+	return c1<<16 | c2<<24 | c3 | uint32(length)<<8
+}
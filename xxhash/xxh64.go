@@ -0,0 +1,183 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package xxhash
+
+import (
+	"math/bits"
+)
+
+// ******** Public constants ********
+
+// Size64 is the size of an XXH64 or XXH3 64 bit hash value in bytes.
+const Size64 = 8
+
+// ******** Private constants ********
+
+// stripeLen64 is the length of a stripe of XXH64 in bytes.
+const stripeLen64 = 32
+
+// ******** Public types ********
+
+// Digest64 implements the XXH64 hash function.
+type Digest64 struct {
+	seed         uint64
+	v            [4]uint64
+	buffer       [stripeLen64]byte
+	bufferedSize int
+	totalLength  uint64
+}
+
+// ******** Public functions ********
+
+// New64 creates a new XXH64 hash function with the given seed.
+func New64(seed uint64) *Digest64 {
+	d := &Digest64{seed: seed}
+	d.Reset()
+
+	return d
+}
+
+// -------- Digest64 methods --------
+
+// Write adds more data to the running hash.
+func (d *Digest64) Write(p []byte) (int, error) {
+	n := len(p)
+	d.totalLength += uint64(n)
+
+	if d.bufferedSize+n < stripeLen64 {
+		d.bufferedSize += copy(d.buffer[d.bufferedSize:], p)
+		return n, nil
+	}
+
+	if d.bufferedSize != 0 {
+		copied := copy(d.buffer[d.bufferedSize:], p)
+		d.stripe(d.buffer[:])
+		p = p[copied:]
+		d.bufferedSize = 0
+	}
+
+	for len(p) >= stripeLen64 {
+		d.stripe(p)
+		p = p[stripeLen64:]
+	}
+
+	d.bufferedSize = copy(d.buffer[:], p)
+
+	return n, nil
+}
+
+// Sum64 returns the current hash value.
+func (d *Digest64) Sum64() uint64 {
+	var h uint64
+	if d.totalLength >= stripeLen64 {
+		h = bits.RotateLeft64(d.v[0], 1) +
+			bits.RotateLeft64(d.v[1], 7) +
+			bits.RotateLeft64(d.v[2], 12) +
+			bits.RotateLeft64(d.v[3], 18)
+		h = mergeRound64(h, d.v[0])
+		h = mergeRound64(h, d.v[1])
+		h = mergeRound64(h, d.v[2])
+		h = mergeRound64(h, d.v[3])
+	} else {
+		h = d.seed + prime64_5
+	}
+
+	h += d.totalLength
+
+	p := d.buffer[:d.bufferedSize]
+	for len(p) >= 8 {
+		h ^= round64(0, readUint64(p))
+		h = bits.RotateLeft64(h, 27)*prime64_1 + prime64_4
+		p = p[8:]
+	}
+
+	if len(p) >= 4 {
+		h ^= uint64(readUint32(p)) * prime64_1
+		h = bits.RotateLeft64(h, 23)*prime64_2 + prime64_3
+		p = p[4:]
+	}
+
+	for _, b := range p {
+		h ^= uint64(b) * prime64_5
+		h = bits.RotateLeft64(h, 11) * prime64_1
+	}
+
+	return xxh64Avalanche(h)
+}
+
+// Sum appends the current hash value in big-endian byte order to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (d *Digest64) Sum(b []byte) []byte {
+	return appendUint64(b, d.Sum64())
+}
+
+// Reset resets the hash to its initial state.
+func (d *Digest64) Reset() {
+	d.v[0] = d.seed + prime64_1 + prime64_2
+	d.v[1] = d.seed + prime64_2
+	d.v[2] = d.seed
+	d.v[3] = d.seed - prime64_1
+	d.bufferedSize = 0
+	d.totalLength = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (d *Digest64) Size() int {
+	return Size64
+}
+
+// BlockSize returns the hash's underlying block size.
+func (d *Digest64) BlockSize() int {
+	return stripeLen64
+}
+
+// stripe processes one stripe.
+func (d *Digest64) stripe(p []byte) {
+	d.v[0] = round64(d.v[0], readUint64(p))
+	d.v[1] = round64(d.v[1], readUint64(p[8:]))
+	d.v[2] = round64(d.v[2], readUint64(p[16:]))
+	d.v[3] = round64(d.v[3], readUint64(p[24:]))
+}
+
+// ******** Private functions ********
+
+// round64 is the round function of XXH64.
+func round64(acc uint64, input uint64) uint64 {
+	acc += input * prime64_2
+	acc = bits.RotateLeft64(acc, 31)
+
+	return acc * prime64_1
+}
+
+// mergeRound64 merges an accumulator into the hash value.
+func mergeRound64(h uint64, acc uint64) uint64 {
+	h ^= round64(0, acc)
+
+	return h*prime64_1 + prime64_4
+}
@@ -0,0 +1,100 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+// Package xxhash implements the non-cryptographic xxHash hash functions XXH32, XXH64,
+// XXH3 with 64 bits and XXH3 with 128 bits (XXH128) as specified in
+// https://github.com/Cyan4973/xxHash/blob/dev/doc/xxhash_spec.md.
+//
+// All functions can be used with a seed.
+// The hash values are returned in the canonical big-endian representation.
+package xxhash
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// ******** Private constants ********
+
+// These are the 32 bit primes.
+const (
+	prime32_1 uint32 = 0x9e3779b1
+	prime32_2 uint32 = 0x85ebca77
+	prime32_3 uint32 = 0xc2b2ae3d
+	prime32_4 uint32 = 0x27d4eb2f
+	prime32_5 uint32 = 0x165667b1
+)
+
+// These are the 64 bit primes.
+const (
+	prime64_1 uint64 = 0x9e3779b185ebca87
+	prime64_2 uint64 = 0xc2b2ae3d27d4eb4f
+	prime64_3 uint64 = 0x165667b19e3779f9
+	prime64_4 uint64 = 0x85ebca77c2b2ae63
+	prime64_5 uint64 = 0x27d4eb2f165667c5
+)
+
+// ******** Private functions ********
+
+// readUint32 reads a little-endian 32 bit value.
+func readUint32(b []byte) uint32 {
+	return binary.LittleEndian.Uint32(b)
+}
+
+// readUint64 reads a little-endian 64 bit value.
+func readUint64(b []byte) uint64 {
+	return binary.LittleEndian.Uint64(b)
+}
+
+// putUint64 writes a little-endian 64 bit value.
+func putUint64(b []byte, v uint64) {
+	binary.LittleEndian.PutUint64(b, v)
+}
+
+// appendUint64 appends a 64 bit value in big-endian byte order.
+func appendUint64(b []byte, v uint64) []byte {
+	return binary.BigEndian.AppendUint64(b, v)
+}
+
+// xxh64Avalanche is the final mix of XXH64.
+func xxh64Avalanche(h uint64) uint64 {
+	h ^= h >> 33
+	h *= prime64_2
+	h ^= h >> 29
+	h *= prime64_3
+	h ^= h >> 32
+
+	return h
+}
+
+// mul128Fold64 multiplies two 64 bit values and returns the XOR of the upper and the lower half of the 128 bit product.
+func mul128Fold64(a uint64, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+
+	return hi ^ lo
+}
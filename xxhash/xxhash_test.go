@@ -0,0 +1,226 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-16: V1.0.0: Created.
+//
+
+package xxhash
+
+import (
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"testing"
+)
+
+// ******** Private constants ********
+
+// sanityBufferSize is the size of the sanity buffer of the xxHash reference test suite.
+const sanityBufferSize = 2367
+
+// Seeds used by the xxHash reference test suite.
+const (
+	sanitySeed32 = 2654435761
+	sanitySeed64 = 11400714785074694797
+)
+
+// ******** Private types ********
+
+// sanityVector is a test vector of the xxHash reference test suite.
+// If seeded is true, XXH32 and XXH64 use sanitySeed32 and XXH3 uses sanitySeed64.
+type sanityVector struct {
+	length int
+	seeded bool
+	xxh32  string
+	xxh64  string
+	xxh3   string
+	xxh128 string
+}
+
+// ******** Private variables ********
+
+// sanityVectors covers all length classes of XXH32, XXH64 and XXH3.
+var sanityVectors = []sanityVector{
+	{0, false, `02cc5d05`, `ef46db3751d8e999`, `2d06800538d394c2`, `99aa06d3014798d86001c324468d497f`},
+	{0, true, `36b78ae7`, `ac75fda2929b17ef`, `a8a6b918b2f0364a`, `00feaa732a3ce25ea986dfc5d7605bfe`},
+	{1, false, `cf65b03e`, `e934a84adb052768`, `c44bdff4074eecdb`, `a6cd5e9392000f6ac44bdff4074eecdb`},
+	{1, true, `b4545aa4`, `5014607643a9b4c3`, `032be332dd766ef8`, `20e49abcc53b3842032be332dd766ef8`},
+	{3, false, `c23884f5`, `ff7e1959cb50794a`, `54247382a8d6b94d`, `20efc49ff02422ea54247382a8d6b94d`},
+	{3, true, `1a269947`, `aa8584e83660f7d1`, `634b8990b4976373`, `1c7ecf6a308cf00e634b8990b4976373`},
+	{4, false, `a9de7ce9`, `9136a0dca57457ee`, `e5dc74bc51848a51`, `970d585ac632bf8e2e7d8d6876a39fe9`},
+	{4, true, `2baafe83`, `caab286bd8e9fdb5`, `aa2e7eccb0c8f747`, `3d53e5dfd837d927bfaf51f1e67e0b0f`},
+	{6, false, `659f0c97`, `c72565b7154268a8`, `27b56a84cd2d7325`, `082afe0b8162d12a3e7039bdda43cfc6`},
+	{6, true, `0bcf25c5`, `ca4c6723580e8ef6`, `84589c116ab59ab9`, `014bd95a51ca5ddbc5b54d56038e4e40`},
+	{8, false, `a3f6f44b`, `cdbcf538e71d1348`, `24ccc9acaa9f65e4`, `47a7f080d82bb45664c69cab4bb21dc5`},
+	{8, true, `c2a8e239`, `fe0c047a5353cdac`, `8f973410999b8f6b`, `f50cec145bcd5c5a7b29471dc729b5ff`},
+	{9, false, `ffb82a24`, `554b1ae991eda6b6`, `14d5001c15dd3f2b`, `564ef6078950d457ed7ccbc501eb7501`},
+	{9, true, `d35632c6`, `7908265248f6d73f`, `b3ae7333d9013f60`, `6b380b43ffa61042aef5dfc0ac9f9044`},
+	{12, false, `e89b5f9b`, `0723bf50086ead9a`, `a713daf0dfbb77e7`, `6e3efd8fc7802b18061a192713f69ad9`},
+	{12, true, `05a6c4b5`, `8252819f4e506951`, `e7303e1b2336de0e`, `ff0d60acd02ed4015d92b5d7190b12d1`},
+	{16, false, `93ba3759`, `98c90b57fdfcb55c`, `981b17d36c7498c9`, `c68c368ecf8a9c05562980258a998629`},
+	{16, true, `a94fc1e1`, `c900ad2d536b607e`, `663f29333b4db6b1`, `6ffcb80cd33085c80346d13a7a5498c7`},
+	{17, false, `89fdc23e`, `0d39a2d051a30c2c`, `796f5acd3a60f862`, `955fa78643ed3669abbc12d11973d7db`},
+	{17, true, `c9910739`, `495cd68a647c7a22`, `f3ec5067f4306db3`, `d77681219e464828980a14119985a7df`},
+	{24, false, `a6276ff0`, `f75a6dea42dc5bf4`, `a3fe70bf9d3510eb`, `0ce966e4678d37611e7044d28b1b901d`},
+	{24, true, `7ad49212`, `8b7c67eb59778e22`, `850e80fc35bdd690`, `d7895ded1f62559dc6cbf92a70680b19`},
+	{80, false, `b8d7e581`, `99bd5d25eb211099`, `bcdefbbb2c47c90a`, `fdf2cefde9eaac8a454ae6bf7a8a532d`},
+	{80, true, `65d85230`, `5281d5357d0b8ac4`, `c6dd0cb699532e73`, `19bf02d69bc56833a5eac764d1ff1166`},
+	{128, false, `0fd07b71`, `90ca021457d96dc5`, `fcff24126754d861`, `39992220e045260aebb15e34a7fb5ab1`},
+	{128, true, `3bd1140e`, `ed9340a202bcd1cf`, `73fde75280646649`, `a0f7ccb68ee02add8394f5c51f1d8246`},
+	{129, false, `68c9ec37`, `41c280132d697aba`, `98f1b0a679a2ca29`, `03815fc91f1b30b686c9e3bc8f0a3b5c`},
+	{129, true, `2a9476a5`, `1668b87489935ff5`, `21fffdbca099c844`, `ad559266067c0bf3d4aae26fcec7dc03`},
+	{195, false, `70536b96`, `52b73ecdb3ef30e4`, `cd94217ee362ec3a`, `7729543a26b207ee3fb593c086a66075`},
+	{195, true, `5637d2b9`, `9159a6288cd2ed9c`, `ba68003d370cb3d9`, `0326104c4d4849e7cf9d9ec2c8c9913f`},
+	{222, false, `5bd11dbd`, `b641ae8cb691c174`, `b9163b558664d356`, `337e09641b948717f1aebd597cec6b3a`},
+	{222, true, `58803c5f`, `20cb8ab7ae10c14a`, `cd627e7ca214ebfd`, `4740af1ae0618b49c5871b3be4506a30`},
+	{240, false, `fa6b6557`, `b81838d483baee53`, `81c3c2b67f568ccf`, `aa4202daa2769dc85c9aae94c8ebe5a0`},
+	{240, true, `55df41d9`, `a4b3f965b6fe67f8`, `cc0f58c27ef3d8ee`, `29d2133d6ea58c5b604e98db085c1864`},
+	{241, false, `e5f7c54d`, `95d76c8b4d8fc4d6`, `c5a639ecd2030e5e`, `99a80ecf0ecfc647c5a639ecd2030e5e`},
+	{241, true, `13b52081`, `19d5ad5f4bd6cb9f`, `dda9b0a161d4829a`, `ec64afae6a137582dda9b0a161d4829a`},
+	{403, false, `6675ff5a`, `d99858fee82283df`, `cdeb804d65c6dea4`, `1b6de21e332dd73dcdeb804d65c6dea4`},
+	{403, true, `bde7aab8`, `f66589734ad3cf7e`, `6259f6ecfd6443fd`, `bed311971e0be8f26259f6ecfd6443fd`},
+	{2048, false, `7c535464`, `5940f2752bc04387`, `dd59e2c3a5f038e0`, `f736557fd47073a5dd59e2c3a5f038e0`},
+	{2048, true, `89688d5e`, `aa26f33c2898013b`, `66f81670669ababc`, `23cc3a2e75ebaaea66f81670669ababc`},
+	{2367, false, `4c8a9773`, `a82418ddec0ea581`, `cb37aeb9e5d361ed`, `e89c0f6ff369b427cb37aeb9e5d361ed`},
+	{2367, true, `6d5366f6`, `a36a93c18052673a`, `d2db3415b942b42a`, `ccb7a94cca1a6496d2db3415b942b42a`},
+}
+
+// ******** Test functions ********
+
+func TestXXH32(t *testing.T) {
+	runSanityVectors(t, func(v *sanityVector) (hash.Hash, string) {
+		if v.seeded {
+			return New32(sanitySeed32), v.xxh32
+		}
+
+		return New32(0), v.xxh32
+	})
+}
+
+func TestXXH64(t *testing.T) {
+	runSanityVectors(t, func(v *sanityVector) (hash.Hash, string) {
+		if v.seeded {
+			return New64(sanitySeed32), v.xxh64
+		}
+
+		return New64(0), v.xxh64
+	})
+}
+
+func TestXXH3(t *testing.T) {
+	runSanityVectors(t, func(v *sanityVector) (hash.Hash, string) {
+		if v.seeded {
+			return New3(sanitySeed64), v.xxh3
+		}
+
+		return New3(0), v.xxh3
+	})
+}
+
+func TestXXH128(t *testing.T) {
+	runSanityVectors(t, func(v *sanityVector) (hash.Hash, string) {
+		if v.seeded {
+			return New128(sanitySeed64), v.xxh128
+		}
+
+		return New128(0), v.xxh128
+	})
+}
+
+func TestIntegerSums(t *testing.T) {
+	buffer := sanityBuffer()
+
+	for _, v := range sanityVectors {
+		seed32 := uint32(0)
+		seed64 := uint64(0)
+		if v.seeded {
+			seed32 = sanitySeed32
+			seed64 = sanitySeed64
+		}
+
+		d32 := New32(seed32)
+		_, _ = d32.Write(buffer[:v.length])
+		checkIntegerSum(t, &v, fmt.Sprintf(`%08x`, d32.Sum32()), v.xxh32)
+
+		d64 := New64(uint64(seed32))
+		_, _ = d64.Write(buffer[:v.length])
+		checkIntegerSum(t, &v, fmt.Sprintf(`%016x`, d64.Sum64()), v.xxh64)
+
+		d3 := New3(seed64)
+		_, _ = d3.Write(buffer[:v.length])
+		checkIntegerSum(t, &v, fmt.Sprintf(`%016x`, d3.Sum64()), v.xxh3)
+	}
+}
+
+// ******** Private functions ********
+
+// runSanityVectors checks all sanity vectors in one call and in small pieces of data.
+func runSanityVectors(t *testing.T, newHash func(v *sanityVector) (hash.Hash, string)) {
+	t.Helper()
+
+	buffer := sanityBuffer()
+
+	for _, v := range sanityVectors {
+		h, expected := newHash(&v)
+		_, _ = h.Write(buffer[:v.length])
+
+		got := hex.EncodeToString(h.Sum(nil))
+		if got != expected {
+			t.Errorf(`Length %d, seeded %t: got %s, expected %s`, v.length, v.seeded, got, expected)
+		}
+
+		h.Reset()
+		for i := 0; i < v.length; i += 7 {
+			_, _ = h.Write(buffer[i:min(i+7, v.length)])
+		}
+
+		got = hex.EncodeToString(h.Sum(nil))
+		if got != expected {
+			t.Errorf(`Length %d, seeded %t, written in pieces: got %s, expected %s`, v.length, v.seeded, got, expected)
+		}
+	}
+}
+
+// checkIntegerSum compares a hash value returned as an integer with the expected value.
+func checkIntegerSum(t *testing.T, v *sanityVector, got string, expected string) {
+	t.Helper()
+
+	if got != expected {
+		t.Errorf(`Length %d, seeded %t: got integer sum %s, expected %s`, v.length, v.seeded, got, expected)
+	}
+}
+
+// sanityBuffer returns the pseudo-random data of the xxHash reference test suite.
+func sanityBuffer() []byte {
+	result := make([]byte, sanityBufferSize)
+
+	generator := uint64(sanitySeed32)
+	for i := range result {
+		result[i] = byte(generator >> 56)
+		generator *= sanitySeed64
+	}
+
+	return result
+}